@@ -0,0 +1,165 @@
+package coinmarketcap
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache is a pluggable store for raw response bodies, keyed by
+// request. Client.Do consults it before issuing a request and
+// populates it on a miss, so repeated calls for the same info/map
+// data don't burn call credits.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, body []byte, ttl time.Duration)
+	Purge() error
+}
+
+// endpointCacheTTL maps a request path (relative to the API version,
+// e.g. "exchange/info") to how long its response may be served from
+// cache. Endpoints not listed here are never cached.
+var endpointCacheTTL = map[string]time.Duration{
+	"cryptocurrency/listings/latest": 60 * time.Second,
+	"cryptocurrency/info":            5 * time.Minute,
+	"cryptocurrency/map":             24 * time.Hour,
+	"exchange/info":                  5 * time.Minute,
+	"exchange/map":                   24 * time.Hour,
+	"exchange/listings/latest":       60 * time.Second,
+	"global-metrics/quotes/latest":   60 * time.Second,
+}
+
+// cacheTTLForRequestPath looks up the cache TTL for a request's URL
+// path, which is expected to start with the API version segment (e.g.
+// "/v1/exchange/info").
+func cacheTTLForRequestPath(path string) (time.Duration, bool) {
+	path = strings.TrimPrefix(path, "/")
+	if idx := strings.Index(path, "/"); idx >= 0 {
+		path = path[idx+1:]
+	}
+	ttl, ok := endpointCacheTTL[path]
+	return ttl, ok
+}
+
+type memoryCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-process Cache backed by a map. It does not
+// survive process restarts.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+// NewMemoryCache builds an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *MemoryCache) Set(key string, body []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = memoryCacheEntry{body: body, expires: time.Now().Add(ttl)}
+}
+
+func (c *MemoryCache) Purge() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]memoryCacheEntry)
+	return nil
+}
+
+type fileCacheEntry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Body      []byte    `json:"body"`
+}
+
+// FileCache is an on-disk Cache that stores one file per key under
+// Dir, so cached responses survive process restarts. This is what
+// lets repeat cointop-style runs skip re-fetching info/map data.
+type FileCache struct {
+	Dir string
+}
+
+// NewFileCache builds a FileCache rooted at dir, creating it if
+// necessary.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{Dir: dir}, nil
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		os.Remove(c.path(key))
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+func (c *FileCache) Set(key string, body []byte, ttl time.Duration) {
+	data, err := json.Marshal(fileCacheEntry{ExpiresAt: time.Now().Add(ttl), Body: body})
+	if err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(c.path(key), data, 0o644)
+}
+
+func (c *FileCache) Purge() error {
+	entries, err := ioutil.ReadDir(c.Dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.Remove(filepath.Join(c.Dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeCache empties the configured response cache, if any.
+func (c *Client) PurgeCache() error {
+	if c.config == nil || c.config.Cache == nil {
+		return nil
+	}
+	return c.config.Cache.Purge()
+}
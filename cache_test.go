@@ -0,0 +1,58 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDoServesFromCacheOnHit(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+	client.config.Cache = NewMemoryCache()
+
+	for i := 0; i < 2; i++ {
+		req, err := client.NewRequest("GET", "exchange/info?id=1", nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+
+		var v struct{}
+		if _, err := client.Do(context.Background(), req, &v); err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1 (second call should be served from cache)", calls)
+	}
+}
+
+func TestPurgeCacheClearsEntries(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+	client.config.Cache = NewMemoryCache()
+
+	req, err := client.NewRequest("GET", "exchange/info?id=1", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	var v struct{}
+	if _, err := client.Do(context.Background(), req, &v); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	if err := client.PurgeCache(); err != nil {
+		t.Fatalf("PurgeCache: %v", err)
+	}
+
+	cacheKey := "GET " + req.URL.String()
+	if _, ok := client.config.Cache.Get(cacheKey); ok {
+		t.Error("cache entry still present after PurgeCache")
+	}
+}
@@ -0,0 +1,134 @@
+package coinmarketcap
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// cmcProvider adapts the full CoinMarketCap Client onto the
+// backend-agnostic Provider interface.
+type cmcProvider struct {
+	client *Client
+}
+
+func newCMCProvider(cfg *Config) *cmcProvider {
+	c := NewDefaultClient()
+	c.client = cfg.httpClient()
+	if cfg.CMCAPIKey != "" {
+		c.APIKey = cfg.CMCAPIKey
+	}
+	return &cmcProvider{client: c}
+}
+
+func (p *cmcProvider) Ping(ctx context.Context) error {
+	_, _, err := p.client.GlobalMetrics.LatestQuotes(ctx, nil)
+	return err
+}
+
+func (p *cmcProvider) Info(ctx context.Context, query string) (*ProviderListing, error) {
+	resp, _, err := p.client.Cryptocurrency.Info(ctx, &CryptocurrencyInfoOptions{Symbol: query})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range resp.Data {
+		return &ProviderListing{
+			ID:     strconv.Itoa(info.ID),
+			Symbol: info.Symbol,
+			Name:   info.Name,
+			Slug:   info.Slug,
+		}, nil
+	}
+
+	return nil, errors.New("coinmarketcap: no matching cryptocurrency")
+}
+
+func (p *cmcProvider) Listings(ctx context.Context, opts *ListingsOptions) ([]ProviderListing, error) {
+	listOpts := &CryptocurrencyLatestListingsOptions{}
+	if opts != nil {
+		listOpts.Start = opts.Start
+		listOpts.Limit = opts.Limit
+		listOpts.Convert = opts.Convert
+	}
+
+	resp, _, err := p.client.Cryptocurrency.LatestListings(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	listings := make([]ProviderListing, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		quote := item.Quote[listOpts.Convert]
+		listings = append(listings, ProviderListing{
+			ID:               strconv.Itoa(item.ID),
+			Symbol:           item.Symbol,
+			Name:             item.Name,
+			Slug:             item.Slug,
+			Price:            quote.Price,
+			MarketCap:        quote.MarketCap,
+			Volume24h:        quote.Volume24h,
+			PercentChange24h: quote.PercentChange24h,
+		})
+	}
+
+	return listings, nil
+}
+
+func (p *cmcProvider) Quotes(ctx context.Context, query string, convert string) (*ProviderQuote, error) {
+	resp, _, err := p.client.Cryptocurrency.LatestQuotes(ctx, &QuotesLatestOptions{Symbol: query, Convert: convert})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range resp.Data {
+		quote := item.Quote[convert]
+		return &ProviderQuote{
+			ID:          strconv.Itoa(item.ID),
+			Symbol:      item.Symbol,
+			Price:       quote.Price,
+			Volume24h:   quote.Volume24h,
+			MarketCap:   quote.MarketCap,
+			LastUpdated: quote.LastUpdated,
+		}, nil
+	}
+
+	return nil, errors.New("coinmarketcap: no matching cryptocurrency")
+}
+
+func (p *cmcProvider) MarketChart(ctx context.Context, query string, convert string, rangeStr string) (*ProviderMarketChart, error) {
+	return p.client.Cryptocurrency.MarketChart(ctx, query, convert, rangeStr)
+}
+
+func (p *cmcProvider) GlobalMetrics(ctx context.Context, convert string) (*ProviderGlobalMetrics, error) {
+	resp, _, err := p.client.GlobalMetrics.LatestQuotes(ctx, &GlobalMetricsLatestOptions{Convert: convert})
+	if err != nil {
+		return nil, err
+	}
+
+	quote := resp.Data.Quote[convert]
+	return &ProviderGlobalMetrics{
+		ActiveCryptocurrencies: resp.Data.ActiveCryptocurrencies,
+		TotalMarketCap:         quote.TotalMarketCap,
+		TotalVolume24h:         quote.TotalVolume24h,
+		BTCDominance:           resp.Data.BTCDominance,
+	}, nil
+}
+
+func (p *cmcProvider) PriceConversion(ctx context.Context, amount float64, query string, convert string) (*ProviderPriceConversion, error) {
+	resp, _, err := p.client.Tools.PriceConversion(ctx, &PriceConversionOptions{
+		Amount:  amount,
+		Symbol:  query,
+		Convert: convert,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quote := resp.Data.Quote[convert]
+	return &ProviderPriceConversion{
+		Amount:  resp.Data.Amount,
+		Price:   quote.Price,
+		Convert: convert,
+	}, nil
+}
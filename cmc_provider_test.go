@@ -0,0 +1,38 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCMCProviderInfoResolvesAmbiguousSymbolByRank(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/cryptocurrency/map":
+			w.Write([]byte(`{"status":{"credit_count":1},"data":[
+				{"id":1,"rank":500,"name":"Uniswap Clone","symbol":"UNI","slug":"uniswap-clone"},
+				{"id":7083,"rank":20,"name":"Uniswap","symbol":"UNI","slug":"uniswap"}
+			]}`))
+		case "/v1/cryptocurrency/info":
+			if got := r.URL.Query().Get("id"); got != "7083" {
+				t.Errorf("info request id = %q, want the resolved %q", got, "7083")
+			}
+			if got := r.URL.Query().Get("symbol"); got != "" {
+				t.Errorf("info request symbol = %q, want empty once resolved to an id", got)
+			}
+			w.Write([]byte(`{"status":{"credit_count":1},"data":{"7083":{"id":7083,"name":"Uniswap","symbol":"UNI","slug":"uniswap"}}}`))
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+	})
+
+	p := &cmcProvider{client: client}
+	listing, err := p.Info(context.Background(), "UNI")
+	if err != nil {
+		t.Fatalf("Info: %v", err)
+	}
+	if listing.ID != "7083" || listing.Name != "Uniswap" {
+		t.Errorf("Info(UNI) = %+v, want the higher-ranked Uniswap project", listing)
+	}
+}
@@ -0,0 +1,272 @@
+// Package coingecko is a minimal client for CoinGecko's v3 API. It
+// has no dependency on the coinmarketcap package; the
+// coinmarketcap.Provider adapter for this backend lives in
+// coingecko_provider.go at the repository root and converts these
+// types into the shared Provider* types.
+package coingecko
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultBaseURL = "https://api.coingecko.com/api/v3/"
+	proBaseURL     = "https://pro-api.coingecko.com/api/v3/"
+)
+
+// Client talks to the CoinGecko v3 API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	apiKey     string
+}
+
+// NewClient builds a CoinGecko Client. When apiKey is non-empty,
+// requests are sent to the Pro API with the x-cg-pro-api-key header
+// set; otherwise the free public API is used.
+func NewClient(httpClient *http.Client, apiKey string) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	rawURL := defaultBaseURL
+	if apiKey != "" {
+		rawURL = proBaseURL
+	}
+	baseURL, _ := url.Parse(rawURL)
+
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+	}
+}
+
+// NewClientWithBaseURL builds a Client against a custom API root,
+// e.g. a self-hosted CoinGecko-compatible proxy, or a test server.
+// Most callers want NewClient instead.
+func NewClientWithBaseURL(httpClient *http.Client, apiKey, rawBaseURL string) (*Client, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	baseURL, err := url.Parse(rawBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+	}, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	u, err := c.baseURL.Parse(path)
+	if err != nil {
+		return err
+	}
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.apiKey != "" {
+		req.Header.Set("x-cg-pro-api-key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("coingecko: unexpected status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Ping verifies the configured CoinGecko endpoint is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	var result struct {
+		GeckoSays string `json:"gecko_says"`
+	}
+	return c.get(ctx, "ping", nil, &result)
+}
+
+// CoinMarket is a single entry returned by /coins/markets.
+type CoinMarket struct {
+	ID                       string  `json:"id"`
+	Symbol                   string  `json:"symbol"`
+	Name                     string  `json:"name"`
+	CurrentPrice             float64 `json:"current_price"`
+	MarketCap                float64 `json:"market_cap"`
+	TotalVolume              float64 `json:"total_volume"`
+	PriceChangePercentage24h float64 `json:"price_change_percentage_24h"`
+}
+
+// CoinMarketsOptions are the query parameters accepted by
+// /coins/markets.
+type CoinMarketsOptions struct {
+	VsCurrency string
+	IDs        string
+	Page       int
+	PerPage    int
+}
+
+// CoinMarkets returns ranked coins with latest market data.
+func (c *Client) CoinMarkets(ctx context.Context, opts *CoinMarketsOptions) ([]CoinMarket, error) {
+	vsCurrency := "usd"
+	v := url.Values{}
+	if opts != nil {
+		if opts.VsCurrency != "" {
+			vsCurrency = opts.VsCurrency
+		}
+		if opts.IDs != "" {
+			v.Set("ids", opts.IDs)
+		}
+		if opts.Page > 0 {
+			v.Set("page", strconv.Itoa(opts.Page))
+		}
+		if opts.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(opts.PerPage))
+		}
+	}
+	v.Set("vs_currency", strings.ToLower(vsCurrency))
+
+	var markets []CoinMarket
+	if err := c.get(ctx, "coins/markets", v, &markets); err != nil {
+		return nil, err
+	}
+	return markets, nil
+}
+
+// CoinInfo is the static metadata returned by /coins/{id}.
+type CoinInfo struct {
+	ID     string `json:"id"`
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+}
+
+// Coin returns static metadata for a single coin, identified by its
+// CoinGecko id.
+func (c *Client) Coin(ctx context.Context, id string) (*CoinInfo, error) {
+	var info CoinInfo
+	v := url.Values{}
+	v.Set("localization", "false")
+	v.Set("tickers", "false")
+	v.Set("market_data", "false")
+	v.Set("community_data", "false")
+	v.Set("developer_data", "false")
+	if err := c.get(ctx, "coins/"+id, v, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GlobalData is the aggregate market data returned by /global.
+type GlobalData struct {
+	ActiveCryptocurrencies int                `json:"active_cryptocurrencies"`
+	TotalMarketCap         map[string]float64 `json:"total_market_cap"`
+	TotalVolume            map[string]float64 `json:"total_volume"`
+	MarketCapPercentage    map[string]float64 `json:"market_cap_percentage"`
+}
+
+// Global returns aggregate market-wide data.
+func (c *Client) Global(ctx context.Context) (*GlobalData, error) {
+	var result struct {
+		Data GlobalData `json:"data"`
+	}
+	if err := c.get(ctx, "global", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// MarketChartPoint is a single [timestampMillis, value] sample, the
+// shape CoinGecko returns market_chart series in.
+type MarketChartPoint [2]float64
+
+// MarketChart is the price history returned by
+// /coins/{id}/market_chart.
+type MarketChart struct {
+	Prices []MarketChartPoint `json:"prices"`
+}
+
+// MarketChartRange fetches a price history series for a coin over
+// the given number of days ("1", "7", "30", "365", or "max").
+func (c *Client) MarketChartRange(ctx context.Context, id, vsCurrency, days string) (*MarketChart, error) {
+	v := url.Values{}
+	v.Set("vs_currency", strings.ToLower(vsCurrency))
+	v.Set("days", days)
+
+	var chart MarketChart
+	if err := c.get(ctx, "coins/"+id+"/market_chart", v, &chart); err != nil {
+		return nil, err
+	}
+	return &chart, nil
+}
+
+// SimplePrice returns the current price of one or more coins in one
+// or more target currencies, via /simple/price.
+func (c *Client) SimplePrice(ctx context.Context, ids, vsCurrencies string) (map[string]map[string]float64, error) {
+	v := url.Values{}
+	v.Set("ids", ids)
+	v.Set("vs_currencies", strings.ToLower(vsCurrencies))
+
+	var result map[string]map[string]float64
+	if err := c.get(ctx, "simple/price", v, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CoinListEntry is a single /coins/list row: a coin's id, symbol, and
+// name with no market data.
+type CoinListEntry struct {
+	ID     string `json:"id"`
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+}
+
+// CoinsList returns every coin CoinGecko tracks. It's used to resolve
+// a ticker symbol to the id CoinGecko's id-keyed endpoints expect.
+func (c *Client) CoinsList(ctx context.Context) ([]CoinListEntry, error) {
+	var entries []CoinListEntry
+	if err := c.get(ctx, "coins/list", nil, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// DaysForRange converts a human range string ("1h", "24h", "7d",
+// "30d", "1y", "all") into the "days" parameter CoinGecko's
+// market_chart endpoint expects.
+func DaysForRange(rangeStr string) string {
+	switch strings.ToLower(rangeStr) {
+	case "1h", "24h", "1d", "":
+		return "1"
+	case "7d":
+		return "7"
+	case "30d":
+		return "30"
+	case "1y":
+		return "365"
+	case "all":
+		return "max"
+	default:
+		return "1"
+	}
+}
@@ -0,0 +1,55 @@
+package coingecko
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClientWithBaseURL(nil, "", server.URL+"/")
+	if err != nil {
+		t.Fatalf("NewClientWithBaseURL: %v", err)
+	}
+	return client
+}
+
+func TestCoinsList(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coins/list" {
+			t.Errorf("request path = %q, want %q", r.URL.Path, "/coins/list")
+		}
+		w.Write([]byte(`[{"id":"bitcoin","symbol":"btc","name":"Bitcoin"}]`))
+	})
+
+	entries, err := client.CoinsList(context.Background())
+	if err != nil {
+		t.Fatalf("CoinsList: %v", err)
+	}
+	if len(entries) != 1 || entries[0].ID != "bitcoin" {
+		t.Errorf("CoinsList() = %v, want a single bitcoin entry", entries)
+	}
+}
+
+func TestCoinReturnsMetadata(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coins/bitcoin" {
+			t.Errorf("request path = %q, want %q", r.URL.Path, "/coins/bitcoin")
+		}
+		w.Write([]byte(`{"id":"bitcoin","symbol":"btc","name":"Bitcoin"}`))
+	})
+
+	info, err := client.Coin(context.Background(), "bitcoin")
+	if err != nil {
+		t.Fatalf("Coin: %v", err)
+	}
+	if info.Name != "Bitcoin" {
+		t.Errorf("Coin(bitcoin).Name = %q, want %q", info.Name, "Bitcoin")
+	}
+}
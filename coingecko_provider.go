@@ -0,0 +1,263 @@
+package coinmarketcap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/whyrans/go-coinmarketcap/coingecko"
+)
+
+// coinGeckoListTTL bounds how long coinGeckoResolver serves the
+// /coins/list it fetched before refreshing it. The list changes
+// rarely enough that a day-long cache is safe.
+const coinGeckoListTTL = 24 * time.Hour
+
+// coinGeckoProvider adapts coingecko.Client onto the backend-agnostic
+// Provider interface.
+type coinGeckoProvider struct {
+	client   *coingecko.Client
+	resolver *coinGeckoResolver
+}
+
+func newCoinGeckoProvider(cfg *Config) *coinGeckoProvider {
+	client := coingecko.NewClient(cfg.httpClient(), cfg.CoinGeckoAPIKey)
+	return &coinGeckoProvider{
+		client:   client,
+		resolver: newCoinGeckoResolver(client),
+	}
+}
+
+// coinGeckoResolver lazily fetches and caches CoinGecko's full
+// /coins/list so a ticker symbol (e.g. "BTC") can be turned into the
+// id CoinGecko's id-keyed endpoints expect (e.g. "bitcoin"),
+// disambiguating a collided symbol by market cap the same way
+// Resolver disambiguates CMC symbol collisions by rank.
+type coinGeckoResolver struct {
+	client *coingecko.Client
+
+	mu       sync.Mutex
+	entries  []coingecko.CoinListEntry
+	loadedAt time.Time
+}
+
+func newCoinGeckoResolver(client *coingecko.Client) *coinGeckoResolver {
+	return &coinGeckoResolver{client: client}
+}
+
+func (r *coinGeckoResolver) load(ctx context.Context) ([]coingecko.CoinListEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.entries != nil && time.Since(r.loadedAt) < coinGeckoListTTL {
+		return r.entries, nil
+	}
+
+	entries, err := r.client.CoinsList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.entries = entries
+	r.loadedAt = time.Now()
+	return r.entries, nil
+}
+
+// resolveQuery turns query — a CoinGecko id, a slug (CoinGecko uses
+// the same string for both, e.g. "bitcoin"), or a ticker symbol (e.g.
+// "BTC") — into the id CoinGecko's id-keyed endpoints expect.
+func (r *coinGeckoResolver) resolveQuery(ctx context.Context, query string) (string, error) {
+	query = strings.ToLower(query)
+
+	entries, err := r.load(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		if entry.ID == query {
+			return entry.ID, nil
+		}
+	}
+
+	var matches []coingecko.CoinListEntry
+	for _, entry := range entries {
+		if strings.EqualFold(entry.Symbol, query) {
+			matches = append(matches, entry)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("coingecko: no cryptocurrency found for %q", query)
+	case 1:
+		return matches[0].ID, nil
+	default:
+		return r.highestMarketCap(ctx, matches)
+	}
+}
+
+// highestMarketCap breaks a ticker-symbol collision by picking the
+// match with the largest market cap, since that's almost always the
+// project a caller means (e.g. "UNI" the Uniswap token, not an
+// obscure clone).
+func (r *coinGeckoResolver) highestMarketCap(ctx context.Context, matches []coingecko.CoinListEntry) (string, error) {
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+
+	markets, err := r.client.CoinMarkets(ctx, &coingecko.CoinMarketsOptions{IDs: strings.Join(ids, ",")})
+	if err != nil {
+		return "", err
+	}
+
+	var best *coingecko.CoinMarket
+	for i := range markets {
+		if best == nil || markets[i].MarketCap > best.MarketCap {
+			best = &markets[i]
+		}
+	}
+	if best == nil {
+		return "", fmt.Errorf("coingecko: no market data to disambiguate %d matches for %q", len(matches), matches[0].Symbol)
+	}
+	return best.ID, nil
+}
+
+func (p *coinGeckoProvider) Ping(ctx context.Context) error {
+	return p.client.Ping(ctx)
+}
+
+func (p *coinGeckoProvider) Info(ctx context.Context, query string) (*ProviderListing, error) {
+	id, err := p.resolver.resolveQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := p.client.Coin(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProviderListing{
+		ID:     info.ID,
+		Symbol: strings.ToUpper(info.Symbol),
+		Name:   info.Name,
+		Slug:   info.ID,
+	}, nil
+}
+
+func (p *coinGeckoProvider) Listings(ctx context.Context, opts *ListingsOptions) ([]ProviderListing, error) {
+	marketOpts := &coingecko.CoinMarketsOptions{}
+	if opts != nil {
+		marketOpts.VsCurrency = opts.Convert
+		marketOpts.Page = opts.Start
+		marketOpts.PerPage = opts.Limit
+	}
+
+	markets, err := p.client.CoinMarkets(ctx, marketOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	listings := make([]ProviderListing, 0, len(markets))
+	for _, m := range markets {
+		listings = append(listings, ProviderListing{
+			ID:               m.ID,
+			Symbol:           strings.ToUpper(m.Symbol),
+			Name:             m.Name,
+			Slug:             m.ID,
+			Price:            m.CurrentPrice,
+			MarketCap:        m.MarketCap,
+			Volume24h:        m.TotalVolume,
+			PercentChange24h: m.PriceChangePercentage24h,
+		})
+	}
+
+	return listings, nil
+}
+
+func (p *coinGeckoProvider) Quotes(ctx context.Context, query string, convert string) (*ProviderQuote, error) {
+	id, err := p.resolver.resolveQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := p.client.SimplePrice(ctx, id, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	byCurrency, ok := prices[id]
+	if !ok {
+		return nil, errors.New("coingecko: no matching cryptocurrency")
+	}
+
+	return &ProviderQuote{
+		ID:     id,
+		Symbol: strings.ToUpper(query),
+		Price:  byCurrency[strings.ToLower(convert)],
+	}, nil
+}
+
+func (p *coinGeckoProvider) MarketChart(ctx context.Context, query string, convert string, rangeStr string) (*ProviderMarketChart, error) {
+	id, err := p.resolver.resolveQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	chart, err := p.client.MarketChartRange(ctx, id, convert, coingecko.DaysForRange(rangeStr))
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]ProviderMarketChartPoint, 0, len(chart.Prices))
+	for _, sample := range chart.Prices {
+		points = append(points, ProviderMarketChartPoint{
+			Timestamp: time.UnixMilli(int64(sample[0])),
+			Price:     sample[1],
+		})
+	}
+
+	return &ProviderMarketChart{ID: id, Points: points}, nil
+}
+
+func (p *coinGeckoProvider) GlobalMetrics(ctx context.Context, convert string) (*ProviderGlobalMetrics, error) {
+	global, err := p.client.Global(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProviderGlobalMetrics{
+		ActiveCryptocurrencies: global.ActiveCryptocurrencies,
+		TotalMarketCap:         global.TotalMarketCap[strings.ToLower(convert)],
+		TotalVolume24h:         global.TotalVolume[strings.ToLower(convert)],
+		BTCDominance:           global.MarketCapPercentage["btc"],
+	}, nil
+}
+
+func (p *coinGeckoProvider) PriceConversion(ctx context.Context, amount float64, query string, convert string) (*ProviderPriceConversion, error) {
+	id, err := p.resolver.resolveQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := p.client.SimplePrice(ctx, id, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	byCurrency, ok := prices[id]
+	if !ok {
+		return nil, errors.New("coingecko: no matching cryptocurrency")
+	}
+
+	return &ProviderPriceConversion{
+		Amount:  amount,
+		Price:   amount * byCurrency[strings.ToLower(convert)],
+		Convert: convert,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/whyrans/go-coinmarketcap/coingecko"
+)
+
+func newTestCoinGeckoProvider(t *testing.T, handler http.HandlerFunc) *coinGeckoProvider {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := coingecko.NewClientWithBaseURL(nil, "", server.URL+"/")
+	if err != nil {
+		t.Fatalf("NewClientWithBaseURL: %v", err)
+	}
+	return &coinGeckoProvider{client: client, resolver: newCoinGeckoResolver(client)}
+}
+
+func TestCoinGeckoProviderInfoResolvesSymbolToID(t *testing.T) {
+	p := newTestCoinGeckoProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coins/list":
+			w.Write([]byte(`[{"id":"bitcoin","symbol":"btc","name":"Bitcoin"}]`))
+		case "/coins/bitcoin":
+			w.Write([]byte(`{"id":"bitcoin","symbol":"btc","name":"Bitcoin"}`))
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+	})
+
+	listing, err := p.Info(context.Background(), "BTC")
+	if err != nil {
+		t.Fatalf("Info: %v", err)
+	}
+	if listing.ID != "bitcoin" || listing.Symbol != "BTC" {
+		t.Errorf("Info(BTC) = %+v, want the bitcoin listing", listing)
+	}
+}
+
+func TestCoinGeckoProviderInfoResolvesAmbiguousSymbolByMarketCap(t *testing.T) {
+	p := newTestCoinGeckoProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coins/list":
+			w.Write([]byte(`[
+				{"id":"uniswap-clone","symbol":"uni","name":"Uniswap Clone"},
+				{"id":"uniswap","symbol":"uni","name":"Uniswap"}
+			]`))
+		case "/coins/markets":
+			if got := r.URL.Query().Get("ids"); got != "uniswap-clone,uniswap" {
+				t.Errorf("markets request ids = %q, want %q", got, "uniswap-clone,uniswap")
+			}
+			w.Write([]byte(`[
+				{"id":"uniswap-clone","symbol":"uni","name":"Uniswap Clone","market_cap":1000},
+				{"id":"uniswap","symbol":"uni","name":"Uniswap","market_cap":5000000000}
+			]`))
+		case "/coins/uniswap":
+			w.Write([]byte(`{"id":"uniswap","symbol":"uni","name":"Uniswap"}`))
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+	})
+
+	listing, err := p.Info(context.Background(), "UNI")
+	if err != nil {
+		t.Fatalf("Info: %v", err)
+	}
+	if listing.ID != "uniswap" {
+		t.Errorf("Info(UNI).ID = %q, want the higher-market-cap %q", listing.ID, "uniswap")
+	}
+}
+
+func TestCoinGeckoProviderQuotesPassesIDThrough(t *testing.T) {
+	p := newTestCoinGeckoProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coins/list":
+			w.Write([]byte(`[{"id":"bitcoin","symbol":"btc","name":"Bitcoin"}]`))
+		case "/simple/price":
+			if got := r.URL.Query().Get("ids"); got != "bitcoin" {
+				t.Errorf("simple/price request ids = %q, want %q", got, "bitcoin")
+			}
+			w.Write([]byte(`{"bitcoin":{"usd":50000}}`))
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+	})
+
+	quote, err := p.Quotes(context.Background(), "bitcoin", "usd")
+	if err != nil {
+		t.Fatalf("Quotes: %v", err)
+	}
+	if quote.Price != 50000 {
+		t.Errorf("Quotes(bitcoin).Price = %v, want 50000", quote.Price)
+	}
+}
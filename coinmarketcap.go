@@ -11,7 +11,10 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/whyrans/go-coinmarketcap/types"
 )
 
 const (
@@ -38,20 +41,38 @@ type Client struct {
 	client    *http.Client // Http Client use to communicate with the API.
 	BaseURL   *url.URL     // BaseURL for API request.
 	SearchURL *url.URL     // SearchURL for search information request.
+	APIKey    string       // APIKey sent as X-CMC_PRO_API_KEY on every NewRequest/NewRequestV2 request, if set.
 
 	// Services used for talking to different parts of the Coinmarketcap API.
 	Cryptocurrency *CryptocurrencyService
-	// Exchange       *ExchangeService
-	// GlobalMetrics  *GlobalMetricsService
-	// Tools          *ToolsService
-	Search *SearchService
+	Exchange       *ExchangeService
+	GlobalMetrics  *GlobalMetricsService
+	Tools          *ToolsService
+	Search         *SearchService
 
 	RateLimit *RateLimit // Rate limits for the client as determined by the most recent API calls.
+	Resolver  *Resolver  // Resolver resolves symbols and slugs to canonical cryptocurrency ids.
 	config    *ConfigService
+
+	subsMu sync.Mutex
+	subs   *subscriptionHub
 }
 
 type ConfigService struct {
 	AutoCheckRateLimit bool
+
+	// PlanCreditsPerMinute, PlanCreditsPerDay, and PlanCreditsPerMonth
+	// describe the caller's CMC plan limits. When AutoCheckRateLimit
+	// is set, Do blocks (respecting ctx) or returns ErrRateLimited
+	// rather than issuing a request that would exceed them. Zero means
+	// that window isn't tracked.
+	PlanCreditsPerMinute int
+	PlanCreditsPerDay    int
+	PlanCreditsPerMonth  int
+
+	// Cache, if set, short-circuits GET requests to endpoints listed
+	// in endpointCacheTTL with a previously cached response body.
+	Cache Cache
 }
 
 type service struct {
@@ -101,17 +122,25 @@ func NewDefaultClient() *Client {
 		client:    http.DefaultClient,
 		BaseURL:   baseURL,
 		SearchURL: searchURL,
+		RateLimit: &RateLimit{Core: &Rate{}, Search: &Rate{}},
 		config: &ConfigService{
 			AutoCheckRateLimit: false,
 		},
 	}
 
 	c.Cryptocurrency = &CryptocurrencyService{client: c}
+	c.Exchange = &ExchangeService{client: c}
+	c.GlobalMetrics = &GlobalMetricsService{client: c}
+	c.Tools = &ToolsService{client: c}
 	c.Search = &SearchService{client: c}
+	c.Resolver = newResolver(c)
 	return c
 }
 
-func NewClient(httpClient *http.Client, versionAPI string) *Client {
+// NewCMCClient builds a CoinMarketCap Client directly, bypassing the
+// Provider abstraction. Use this when you need CMC-specific
+// functionality that the unified Provider interface does not expose.
+func NewCMCClient(httpClient *http.Client, versionAPI string) *Client {
 	if httpClient == nil && versionAPI == "" {
 		return NewDefaultClient()
 	}
@@ -127,18 +156,42 @@ func NewClient(httpClient *http.Client, versionAPI string) *Client {
 	URL := defaultBaseURL + versionAPI
 	baseURL, _ := url.Parse(URL)
 
-	return &Client{
-		client:  httpClient,
-		BaseURL: baseURL,
+	c := &Client{
+		client:    httpClient,
+		BaseURL:   baseURL,
+		RateLimit: &RateLimit{Core: &Rate{}, Search: &Rate{}},
+		config:    &ConfigService{},
 	}
+
+	c.Cryptocurrency = &CryptocurrencyService{client: c}
+	c.Exchange = &ExchangeService{client: c}
+	c.GlobalMetrics = &GlobalMetricsService{client: c}
+	c.Tools = &ToolsService{client: c}
+	c.Search = &SearchService{client: c}
+	c.Resolver = newResolver(c)
+	return c
 }
 
 func (c *Client) NewRequest(method, urlString string, body interface{}) (*http.Request, error) {
-	if !strings.HasSuffix(c.BaseURL.Path, "/") {
-		return nil, fmt.Errorf("Base URL must have a trailing slash, but %s does not.", c.BaseURL)
+	return c.newRequest(c.BaseURL, method, urlString, body)
+}
+
+// NewRequestV2 behaves like NewRequest, but resolves urlString
+// against CMC's v2 API root instead of BaseURL's v1 root. A handful
+// of endpoints (e.g. cryptocurrency/quotes/latest,
+// cryptocurrency/ohlcv/historical) are only served under v2.
+func (c *Client) NewRequestV2(method, urlString string, body interface{}) (*http.Request, error) {
+	v2BaseURL := *c.BaseURL
+	v2BaseURL.Path = strings.TrimSuffix(v2BaseURL.Path, "v1/") + "v2/"
+	return c.newRequest(&v2BaseURL, method, urlString, body)
+}
+
+func (c *Client) newRequest(baseURL *url.URL, method, urlString string, body interface{}) (*http.Request, error) {
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		return nil, fmt.Errorf("Base URL must have a trailing slash, but %s does not.", baseURL)
 	}
 
-	u, errParse := c.BaseURL.Parse(urlString)
+	u, errParse := baseURL.Parse(urlString)
 	if errParse != nil {
 		return nil, errParse
 	}
@@ -162,6 +215,9 @@ func (c *Client) NewRequest(method, urlString string, body interface{}) (*http.R
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if c.APIKey != "" {
+		req.Header.Set("X-CMC_PRO_API_KEY", c.APIKey)
+	}
 	return req, nil
 }
 
@@ -198,7 +254,24 @@ func (c *Client) NewSearchRequest(method, urlString string, body interface{}) (*
 }
 
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	var cacheKey string
+	if c.config != nil && c.config.Cache != nil && req.Method == http.MethodGet {
+		cacheKey = req.Method + " " + req.URL.String()
+		if cached, ok := c.config.Cache.Get(cacheKey); ok {
+			if err := json.Unmarshal(cached, v); err != nil {
+				return nil, err
+			}
+			return &Response{Response: &http.Response{StatusCode: ResponseSuccessful}}, nil
+		}
+	}
+
+	if c.config != nil && c.config.AutoCheckRateLimit {
+		if err := c.RateLimit.awaitBudget(ctx, c.APIKey, c.config); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -211,10 +284,27 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		return nil, err
 	}
 
+	if resp.StatusCode == ReponseTooManyRequest {
+		return nil, &ErrRateLimited{Window: "unknown", RetryAt: time.Now().Add(time.Minute)}
+	}
+
 	if resp.StatusCode != ResponseSuccessful {
 		return nil, fmt.Errorf("%s", body)
 	}
 
+	var envelope struct {
+		Status types.Status `json:"status"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		c.RateLimit.recordCredits(c.APIKey, envelope.Status.CreditCount)
+	}
+
+	if cacheKey != "" {
+		if ttl, ok := cacheTTLForRequestPath(req.URL.Path); ok {
+			c.config.Cache.Set(cacheKey, body, ttl)
+		}
+	}
+
 	if err := json.Unmarshal(body, v); err != nil {
 		return nil, err
 	}
@@ -239,31 +329,21 @@ type Rate struct {
 	Reset time.Time `json:"reset"`
 }
 
-type RateLimit struct {
-	// The rate limit for non-search API requests.
-	Core *Rate
-
-	// The rate limit for search API requests.
-	Search *Rate
-}
-
-func (rl *RateLimit) SetCoreRate(limit int, remaining int, reset time.Time) {
-	rl.Core.Limit = limit
-	rl.Core.Remaining = remaining
-	rl.Core.Reset = reset
-}
-
-func (rl *RateLimit) SetSearchRate(limit int, remaining int, reset time.Time) {
-	rl.Search.Limit = limit
-	rl.Search.Remaining = remaining
-	rl.Search.Reset = reset
-}
-
-// Todo: Get rateLimit for requests
 func newResponse(resHttp *http.Response) *Response {
 	return &Response{Response: resHttp}
 }
 
+type CryptocurrencyService service
 type ExchangeService service
 type GlobalMetricsService service
 type ToolsService service
+type SearchService service
+
+// withQuery appends an encoded query string to path, if v has any
+// values set.
+func withQuery(path string, v url.Values) string {
+	if encoded := v.Encode(); encoded != "" {
+		return path + "?" + encoded
+	}
+	return path
+}
@@ -0,0 +1,214 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// OHLCVHistoricalOptions are the query parameters accepted by
+// /v2/cryptocurrency/ohlcv/historical. Either ID or Symbol must be
+// set.
+type OHLCVHistoricalOptions struct {
+	ID        string
+	Symbol    string
+	TimeStart string
+	TimeEnd   string
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// OHLCVHistorical returns historical OHLCV (open, high, low, close)
+// candles for one or more cryptocurrencies.
+func (s *CryptocurrencyService) OHLCVHistorical(ctx context.Context, opts *OHLCVHistoricalOptions) (*types.OHLCVHistoricalResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.ID != "" {
+			v.Set("id", opts.ID)
+		}
+		if opts.Symbol != "" {
+			v.Set("symbol", opts.Symbol)
+		}
+		if opts.TimeStart != "" {
+			v.Set("time_start", opts.TimeStart)
+		}
+		if opts.TimeEnd != "" {
+			v.Set("time_end", opts.TimeEnd)
+		}
+		if opts.Count > 0 {
+			v.Set("count", strconv.Itoa(opts.Count))
+		}
+		if opts.Interval != "" {
+			v.Set("interval", opts.Interval)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+	}
+
+	req, err := s.client.NewRequestV2("GET", withQuery("cryptocurrency/ohlcv/historical", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.OHLCVHistoricalResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// QuotesHistoricalOptions are the query parameters accepted by
+// /v2/cryptocurrency/quotes/historical. Either ID or Symbol must be
+// set.
+type QuotesHistoricalOptions struct {
+	ID        string
+	Symbol    string
+	TimeStart string
+	TimeEnd   string
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// QuotesHistorical returns historical market quotes for one or more
+// cryptocurrencies.
+func (s *CryptocurrencyService) QuotesHistorical(ctx context.Context, opts *QuotesHistoricalOptions) (*types.QuotesHistoricalResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.ID != "" {
+			v.Set("id", opts.ID)
+		}
+		if opts.Symbol != "" {
+			v.Set("symbol", opts.Symbol)
+		}
+		if opts.TimeStart != "" {
+			v.Set("time_start", opts.TimeStart)
+		}
+		if opts.TimeEnd != "" {
+			v.Set("time_end", opts.TimeEnd)
+		}
+		if opts.Count > 0 {
+			v.Set("count", strconv.Itoa(opts.Count))
+		}
+		if opts.Interval != "" {
+			v.Set("interval", opts.Interval)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+	}
+
+	req, err := s.client.NewRequestV2("GET", withQuery("cryptocurrency/quotes/historical", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.QuotesHistoricalResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// maxChartPointsPerCall bounds how many samples a single
+// quotes/historical call is asked for, so MarketChart can stitch
+// together ranges that exceed what CMC returns in one response.
+const maxChartPointsPerCall = 500
+
+// chartRangeDuration converts a human range string into the span of
+// time MarketChart should cover.
+func chartRangeDuration(rangeStr string) time.Duration {
+	switch strings.ToLower(rangeStr) {
+	case "1h":
+		return time.Hour
+	case "24h", "1d", "":
+		return 24 * time.Hour
+	case "7d":
+		return 7 * 24 * time.Hour
+	case "30d":
+		return 30 * 24 * time.Hour
+	case "1y":
+		return 365 * 24 * time.Hour
+	case "all":
+		return 10 * 365 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// chartInterval picks the sampling interval for a MarketChart span
+// (5m for spans up to 24h, hourly up to 30 days, daily beyond that),
+// and returns the step between samples at that interval.
+func chartInterval(span time.Duration) (interval string, step time.Duration) {
+	switch {
+	case span <= 24*time.Hour:
+		return "5m", 5 * time.Minute
+	case span <= 30*24*time.Hour:
+		return "hourly", time.Hour
+	default:
+		return "daily", 24 * time.Hour
+	}
+}
+
+// MarketChart returns a price history series for query (an id,
+// symbol, or slug) over a human range ("1h", "24h", "7d", "30d",
+// "1y", or "all"), auto-selecting the interval from the range's
+// length and stitching together multiple QuotesHistorical calls of
+// at most maxChartPointsPerCall samples each when the range exceeds a
+// single call's limit. The stitched series itself is not capped: a
+// "30d" range yields ~720 hourly points and "all" ~3650 daily ones.
+func (s *CryptocurrencyService) MarketChart(ctx context.Context, query string, convert string, rangeStr string) (*ProviderMarketChart, error) {
+	id, err := s.client.ResolveQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	span := chartRangeDuration(rangeStr)
+	interval, step := chartInterval(span)
+
+	end := time.Now()
+	start := end.Add(-span)
+	callSpan := step * maxChartPointsPerCall
+
+	var points []ProviderMarketChartPoint
+	for callStart := start; callStart.Before(end); {
+		callEnd := callStart.Add(callSpan)
+		if callEnd.After(end) {
+			callEnd = end
+		}
+
+		resp, _, err := s.QuotesHistorical(ctx, &QuotesHistoricalOptions{
+			ID:        id,
+			TimeStart: callStart.Format(time.RFC3339),
+			TimeEnd:   callEnd.Format(time.RFC3339),
+			Interval:  interval,
+			Convert:   convert,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, series := range resp.Data {
+			for _, sample := range series.Quotes {
+				points = append(points, ProviderMarketChartPoint{
+					Timestamp: sample.Timestamp,
+					Price:     sample.Quote[convert].Price,
+				})
+			}
+		}
+
+		callStart = callEnd
+	}
+
+	return &ProviderMarketChart{ID: id, Points: points}, nil
+}
@@ -0,0 +1,52 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestMarketChartSingleCallForShortRange(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if want := "/v2/cryptocurrency/quotes/historical"; r.URL.Path != want {
+			t.Errorf("request path = %q, want %q", r.URL.Path, want)
+		}
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{"1":{"id":1,"name":"Bitcoin","symbol":"BTC","quotes":[
+			{"timestamp":"2026-07-27T00:00:00Z","quote":{"USD":{"price":50000}}}
+		]}}}`))
+	})
+
+	chart, err := client.Cryptocurrency.MarketChart(context.Background(), "1", "USD", "24h")
+	if err != nil {
+		t.Fatalf("MarketChart: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times for a 24h range, want 1", calls)
+	}
+	if len(chart.Points) != 1 || chart.Points[0].Price != 50000 {
+		t.Errorf("chart.Points = %v, want a single 50000 sample", chart.Points)
+	}
+}
+
+func TestMarketChartStitchesMultipleCallsForLongRange(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{"1":{"id":1,"name":"Bitcoin","symbol":"BTC","quotes":[
+			{"timestamp":"2026-07-27T00:00:00Z","quote":{"USD":{"price":50000}}}
+		]}}}`))
+	})
+
+	chart, err := client.Cryptocurrency.MarketChart(context.Background(), "1", "USD", "all")
+	if err != nil {
+		t.Fatalf("MarketChart: %v", err)
+	}
+	if calls <= 1 {
+		t.Errorf("server called %d times for an \"all\" range, want multiple calls stitched together", calls)
+	}
+	if len(chart.Points) != calls {
+		t.Errorf("chart.Points has %d samples, want one per call (%d)", len(chart.Points), calls)
+	}
+}
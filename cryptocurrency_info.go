@@ -0,0 +1,58 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// CryptocurrencyInfoOptions are the query parameters accepted by
+// /v1/cryptocurrency/info. One of ID, Slug, or Symbol must be set.
+// When Symbol or Slug names a single (non comma-separated)
+// cryptocurrency, Info resolves it to the matching id via
+// Client.Resolver before querying CMC, so a collided symbol (e.g.
+// "UNI") can't return the wrong project.
+type CryptocurrencyInfoOptions struct {
+	ID     string
+	Slug   string
+	Symbol string
+	Aux    string
+}
+
+// Info returns static metadata for one or more cryptocurrencies.
+func (s *CryptocurrencyService) Info(ctx context.Context, opts *CryptocurrencyInfoOptions) (*types.CryptocurrencyInfoResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		id, symbol, slug, err := s.client.resolveSingleQueryOption(ctx, opts.ID, opts.Symbol, opts.Slug)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if id != "" {
+			v.Set("id", id)
+		}
+		if slug != "" {
+			v.Set("slug", slug)
+		}
+		if symbol != "" {
+			v.Set("symbol", symbol)
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("cryptocurrency/info", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.CryptocurrencyInfoResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
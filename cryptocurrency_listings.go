@@ -0,0 +1,63 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// CryptocurrencyLatestListingsOptions are the query parameters
+// accepted by /v1/cryptocurrency/listings/latest.
+type CryptocurrencyLatestListingsOptions struct {
+	Start              int
+	Limit              int
+	Sort               string
+	SortDir            string
+	CryptocurrencyType string
+	Convert            string
+	Aux                string
+}
+
+// LatestListings returns all cryptocurrencies with latest market
+// data, ranked by CMC rank.
+func (s *CryptocurrencyService) LatestListings(ctx context.Context, opts *CryptocurrencyLatestListingsOptions) (*types.CryptocurrencyLatestListingsResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.Start > 0 {
+			v.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			v.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Sort != "" {
+			v.Set("sort", opts.Sort)
+		}
+		if opts.SortDir != "" {
+			v.Set("sort_dir", opts.SortDir)
+		}
+		if opts.CryptocurrencyType != "" {
+			v.Set("cryptocurrency_type", opts.CryptocurrencyType)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("cryptocurrency/listings/latest", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.CryptocurrencyLatestListingsResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
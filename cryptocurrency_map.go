@@ -0,0 +1,59 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// CryptocurrencyMapOptions are the query parameters accepted by
+// /v1/cryptocurrency/map.
+type CryptocurrencyMapOptions struct {
+	ListingStatus string
+	Start         int
+	Limit         int
+	Sort          string
+	Symbol        string
+	Aux           string
+}
+
+// Map returns a paginated id, symbol, slug, and name index of all
+// cryptocurrencies. This is the data source for Client.Resolver.
+func (s *CryptocurrencyService) Map(ctx context.Context, opts *CryptocurrencyMapOptions) (*types.CryptocurrencyMapResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.ListingStatus != "" {
+			v.Set("listing_status", opts.ListingStatus)
+		}
+		if opts.Start > 0 {
+			v.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			v.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Sort != "" {
+			v.Set("sort", opts.Sort)
+		}
+		if opts.Symbol != "" {
+			v.Set("symbol", opts.Symbol)
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("cryptocurrency/map", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.CryptocurrencyMapResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
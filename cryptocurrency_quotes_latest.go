@@ -0,0 +1,55 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// QuotesLatestOptions are the query parameters accepted by
+// /v2/cryptocurrency/quotes/latest. Either ID or Symbol must be set.
+// When Symbol names a single (non comma-separated) cryptocurrency,
+// LatestQuotes resolves it to the matching id via Client.Resolver
+// before querying CMC, so a collided symbol (e.g. "UNI") can't return
+// the wrong project.
+type QuotesLatestOptions struct {
+	ID      string
+	Symbol  string
+	Convert string
+}
+
+// LatestQuotes returns the latest market quote for one or more
+// cryptocurrencies.
+func (s *CryptocurrencyService) LatestQuotes(ctx context.Context, opts *QuotesLatestOptions) (*types.QuotesLatestResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		id, symbol, _, err := s.client.resolveSingleQueryOption(ctx, opts.ID, opts.Symbol, "")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if id != "" {
+			v.Set("id", id)
+		}
+		if symbol != "" {
+			v.Set("symbol", symbol)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+	}
+
+	req, err := s.client.NewRequestV2("GET", withQuery("cryptocurrency/quotes/latest", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.QuotesLatestResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,53 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestLatestQuotesResolvesAmbiguousSymbolByRank(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/cryptocurrency/map":
+			w.Write([]byte(`{"status":{"credit_count":1},"data":[
+				{"id":1,"rank":500,"name":"Uniswap Clone","symbol":"UNI","slug":"uniswap-clone"},
+				{"id":7083,"rank":20,"name":"Uniswap","symbol":"UNI","slug":"uniswap"}
+			]}`))
+		case "/v2/cryptocurrency/quotes/latest":
+			if got := r.URL.Query().Get("id"); got != "7083" {
+				t.Errorf("quotes/latest request id = %q, want the resolved %q", got, "7083")
+			}
+			if got := r.URL.Query().Get("symbol"); got != "" {
+				t.Errorf("quotes/latest request symbol = %q, want empty once resolved to an id", got)
+			}
+			w.Write([]byte(`{"status":{"credit_count":1},"data":{"7083":{"id":7083,"name":"Uniswap","symbol":"UNI","quote":{"USD":{"price":5}}}}}`))
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+	})
+
+	resp, _, err := client.Cryptocurrency.LatestQuotes(context.Background(), &QuotesLatestOptions{Symbol: "UNI", Convert: "USD"})
+	if err != nil {
+		t.Fatalf("LatestQuotes: %v", err)
+	}
+	if resp.Data["7083"].Name != "Uniswap" {
+		t.Errorf("LatestQuotes(UNI).Data = %v, want the higher-ranked Uniswap project", resp.Data)
+	}
+}
+
+func TestLatestQuotesLeavesBatchSymbolsUnresolved(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/cryptocurrency/quotes/latest" {
+			t.Fatalf("unexpected request path %q, want comma-separated symbols to skip Resolver entirely", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("symbol"); got != "BTC,ETH" {
+			t.Errorf("quotes/latest request symbol = %q, want unresolved %q", got, "BTC,ETH")
+		}
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+
+	if _, _, err := client.Cryptocurrency.LatestQuotes(context.Background(), &QuotesLatestOptions{Symbol: "BTC,ETH"}); err != nil {
+		t.Fatalf("LatestQuotes: %v", err)
+	}
+}
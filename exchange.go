@@ -0,0 +1,206 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// ExchangeInfoOptions are the query parameters accepted by
+// /v1/exchange/info. Either ID or Slug must be set.
+type ExchangeInfoOptions struct {
+	ID   string
+	Slug string
+	Aux  string
+}
+
+// Info returns all static metadata for one or more exchanges.
+func (s *ExchangeService) Info(ctx context.Context, opts *ExchangeInfoOptions) (*types.ExchangeInfoResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.ID != "" {
+			v.Set("id", opts.ID)
+		}
+		if opts.Slug != "" {
+			v.Set("slug", opts.Slug)
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("exchange/info", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.ExchangeInfoResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// ExchangeMapOptions are the query parameters accepted by
+// /v1/exchange/map.
+type ExchangeMapOptions struct {
+	ListingStatus string
+	Start         int
+	Limit         int
+	Sort          string
+	Symbol        string
+	Aux           string
+}
+
+// Map returns a paginated id, name, and slug index of all exchanges.
+func (s *ExchangeService) Map(ctx context.Context, opts *ExchangeMapOptions) (*types.ExchangeMapResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.ListingStatus != "" {
+			v.Set("listing_status", opts.ListingStatus)
+		}
+		if opts.Start > 0 {
+			v.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			v.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Sort != "" {
+			v.Set("sort", opts.Sort)
+		}
+		if opts.Symbol != "" {
+			v.Set("symbol", opts.Symbol)
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("exchange/map", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.ExchangeMapResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// ExchangeLatestListingsOptions are the query parameters accepted by
+// /v1/exchange/listings/latest.
+type ExchangeLatestListingsOptions struct {
+	Start      int
+	Limit      int
+	Sort       string
+	SortDir    string
+	MarketType string
+	Convert    string
+	Aux        string
+}
+
+// LatestListings returns all exchanges with latest market data.
+func (s *ExchangeService) LatestListings(ctx context.Context, opts *ExchangeLatestListingsOptions) (*types.ExchangeLatestListingsResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.Start > 0 {
+			v.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			v.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Sort != "" {
+			v.Set("sort", opts.Sort)
+		}
+		if opts.SortDir != "" {
+			v.Set("sort_dir", opts.SortDir)
+		}
+		if opts.MarketType != "" {
+			v.Set("market_type", opts.MarketType)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("exchange/listings/latest", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.ExchangeLatestListingsResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// ExchangeMarketPairsOptions are the query parameters accepted by
+// /v1/exchange/market-pairs/latest. Either ID or Slug must be set.
+type ExchangeMarketPairsOptions struct {
+	ID       string
+	Slug     string
+	Start    int
+	Limit    int
+	Aux      string
+	Category string
+	FeeType  string
+	Convert  string
+}
+
+// MarketPairsLatest returns the latest market pairs traded on an
+// exchange.
+func (s *ExchangeService) MarketPairsLatest(ctx context.Context, opts *ExchangeMarketPairsOptions) (*types.ExchangeMarketPairsResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.ID != "" {
+			v.Set("id", opts.ID)
+		}
+		if opts.Slug != "" {
+			v.Set("slug", opts.Slug)
+		}
+		if opts.Start > 0 {
+			v.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			v.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Aux != "" {
+			v.Set("aux", opts.Aux)
+		}
+		if opts.Category != "" {
+			v.Set("category", opts.Category)
+		}
+		if opts.FeeType != "" {
+			v.Set("fee_type", opts.FeeType)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("exchange/market-pairs/latest", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.ExchangeMarketPairsResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,82 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// GlobalMetricsLatestOptions are the query parameters accepted by
+// /v1/global-metrics/quotes/latest.
+type GlobalMetricsLatestOptions struct {
+	Convert string
+}
+
+// LatestQuotes returns the latest aggregate market metrics.
+func (s *GlobalMetricsService) LatestQuotes(ctx context.Context, opts *GlobalMetricsLatestOptions) (*types.GlobalMetricsLatestResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil && opts.Convert != "" {
+		v.Set("convert", opts.Convert)
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("global-metrics/quotes/latest", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.GlobalMetricsLatestResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// GlobalMetricsHistoricalOptions are the query parameters accepted by
+// /v1/global-metrics/quotes/historical.
+type GlobalMetricsHistoricalOptions struct {
+	TimeStart string
+	TimeEnd   string
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// HistoricalQuotes returns historical global aggregate market
+// metrics.
+func (s *GlobalMetricsService) HistoricalQuotes(ctx context.Context, opts *GlobalMetricsHistoricalOptions) (*types.GlobalMetricsHistoricalResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		if opts.TimeStart != "" {
+			v.Set("time_start", opts.TimeStart)
+		}
+		if opts.TimeEnd != "" {
+			v.Set("time_end", opts.TimeEnd)
+		}
+		if opts.Count > 0 {
+			v.Set("count", strconv.Itoa(opts.Count))
+		}
+		if opts.Interval != "" {
+			v.Set("interval", opts.Interval)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+	}
+
+	req, err := s.client.NewRequest("GET", withQuery("global-metrics/quotes/historical", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.GlobalMetricsHistoricalResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,179 @@
+package coinmarketcap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// ProviderType selects which backend NewClient wires up.
+type ProviderType int
+
+const (
+	// ProviderCoinMarketCap backs the Provider with the CoinMarketCap
+	// Pro API.
+	ProviderCoinMarketCap ProviderType = iota
+
+	// ProviderCoinGecko backs the Provider with the CoinGecko v3 API.
+	ProviderCoinGecko
+)
+
+// Config carries the credentials and HTTP client shared by every
+// Provider backend.
+type Config struct {
+	// HTTPClient is used to issue requests. Defaults to
+	// http.DefaultClient when nil.
+	HTTPClient *http.Client
+
+	// CMCAPIKey authenticates against the CoinMarketCap Pro API. Falls
+	// back to the CMC_PRO_API_KEY environment variable when empty.
+	CMCAPIKey string
+
+	// CoinGeckoAPIKey authenticates against the CoinGecko Pro API via
+	// the x-cg-pro-api-key header. Falls back to the
+	// COINGECKO_PRO_API_KEY environment variable when empty. Leave
+	// empty to use CoinGecko's free public API.
+	CoinGeckoAPIKey string
+}
+
+// LoadConfigFromEnv builds a Config from CMC_PRO_API_KEY and
+// COINGECKO_PRO_API_KEY.
+func LoadConfigFromEnv() *Config {
+	cfg := &Config{}
+	cfg.applyEnvFallback()
+	return cfg
+}
+
+func (cfg *Config) httpClient() *http.Client {
+	if cfg != nil && cfg.HTTPClient != nil {
+		return cfg.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// applyEnvFallback fills any of cfg's credential fields left empty
+// from CMC_PRO_API_KEY / COINGECKO_PRO_API_KEY, matching the fallback
+// documented on Config.CMCAPIKey and Config.CoinGeckoAPIKey.
+func (cfg *Config) applyEnvFallback() {
+	if cfg.CMCAPIKey == "" {
+		cfg.CMCAPIKey = os.Getenv("CMC_PRO_API_KEY")
+	}
+	if cfg.CoinGeckoAPIKey == "" {
+		cfg.CoinGeckoAPIKey = os.Getenv("COINGECKO_PRO_API_KEY")
+	}
+}
+
+// ProviderListing is a single ranked cryptocurrency entry, normalized
+// across backends.
+type ProviderListing struct {
+	ID               string
+	Symbol           string
+	Name             string
+	Slug             string
+	Price            float64
+	MarketCap        float64
+	Volume24h        float64
+	PercentChange24h float64
+}
+
+// ListingsOptions are the backend-agnostic parameters accepted by
+// Provider.Listings.
+type ListingsOptions struct {
+	Start   int
+	Limit   int
+	Convert string
+}
+
+// ProviderQuote is a single cryptocurrency's latest market quote,
+// normalized across backends.
+type ProviderQuote struct {
+	ID          string
+	Symbol      string
+	Price       float64
+	Volume24h   float64
+	MarketCap   float64
+	LastUpdated time.Time
+}
+
+// ProviderMarketChartPoint is a single timestamped price sample.
+type ProviderMarketChartPoint struct {
+	Timestamp time.Time
+	Price     float64
+}
+
+// ProviderMarketChart is a series of price samples for a single
+// cryptocurrency.
+type ProviderMarketChart struct {
+	ID     string
+	Points []ProviderMarketChartPoint
+}
+
+// ProviderGlobalMetrics is the aggregate market data exposed by both
+// backends.
+type ProviderGlobalMetrics struct {
+	ActiveCryptocurrencies int
+	TotalMarketCap         float64
+	TotalVolume24h         float64
+	BTCDominance           float64
+}
+
+// ProviderPriceConversion is the result of converting an amount of
+// one currency into another.
+type ProviderPriceConversion struct {
+	Amount  float64
+	Price   float64
+	Convert string
+}
+
+// Provider is the backend-agnostic surface implemented by every
+// supported market data source. Consumers that only need this surface
+// should depend on Provider rather than a concrete *Client, so they
+// can switch backends without code changes.
+type Provider interface {
+	// Ping verifies that the backend is reachable and the configured
+	// credentials, if any, are accepted.
+	Ping(ctx context.Context) error
+
+	// Info returns static metadata for a single cryptocurrency,
+	// identified by symbol, slug, or id.
+	Info(ctx context.Context, query string) (*ProviderListing, error)
+
+	// Listings returns ranked cryptocurrencies with latest market
+	// data.
+	Listings(ctx context.Context, opts *ListingsOptions) ([]ProviderListing, error)
+
+	// Quotes returns the latest market quote for a single
+	// cryptocurrency, identified by symbol, slug, or id.
+	Quotes(ctx context.Context, query string, convert string) (*ProviderQuote, error)
+
+	// MarketChart returns a price history series for a single
+	// cryptocurrency over the given human range (e.g. "24h", "7d").
+	MarketChart(ctx context.Context, query string, convert string, rangeStr string) (*ProviderMarketChart, error)
+
+	// GlobalMetrics returns aggregate market-wide data.
+	GlobalMetrics(ctx context.Context, convert string) (*ProviderGlobalMetrics, error)
+
+	// PriceConversion converts amount of query into convert at the
+	// current market rate.
+	PriceConversion(ctx context.Context, amount float64, query string, convert string) (*ProviderPriceConversion, error)
+}
+
+// NewClient builds a Provider backed by the requested vendor. cfg may
+// be nil, in which case credentials are loaded from the environment.
+func NewClient(provider ProviderType, cfg *Config) (Provider, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	cfg.applyEnvFallback()
+
+	switch provider {
+	case ProviderCoinMarketCap:
+		return newCMCProvider(cfg), nil
+	case ProviderCoinGecko:
+		return newCoinGeckoProvider(cfg), nil
+	default:
+		return nil, fmt.Errorf("coinmarketcap: unknown provider %d", provider)
+	}
+}
@@ -0,0 +1,20 @@
+package coinmarketcap
+
+import "testing"
+
+func TestNewClientAppliesEnvFallbackForZeroValueConfig(t *testing.T) {
+	t.Setenv("CMC_PRO_API_KEY", "test-cmc-key")
+
+	p, err := NewClient(ProviderCoinMarketCap, &Config{})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	cmc, ok := p.(*cmcProvider)
+	if !ok {
+		t.Fatalf("NewClient returned %T, want *cmcProvider", p)
+	}
+	if cmc.client.APIKey != "test-cmc-key" {
+		t.Errorf("APIKey = %q, want the CMC_PRO_API_KEY env fallback to apply to a zero-value &Config{}", cmc.client.APIKey)
+	}
+}
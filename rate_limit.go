@@ -0,0 +1,186 @@
+package coinmarketcap
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by Client.Do when AutoCheckRateLimit is
+// set and issuing the request would exceed the configured plan
+// budget for window, or when CMC itself responds 429.
+type ErrRateLimited struct {
+	Window  string
+	RetryAt time.Time
+}
+
+func (e *ErrRateLimited) Error() string {
+	return fmt.Sprintf("coinmarketcap: rate limit exceeded for the %s window, retry after %s", e.Window, e.RetryAt.Format(time.RFC3339))
+}
+
+// CreditWindow tracks call-credit consumption for a single rolling
+// window (minute, day, or month).
+type CreditWindow struct {
+	Limit   int
+	Used    int
+	ResetAt time.Time
+}
+
+func (w *CreditWindow) rollIfExpired(now time.Time, period time.Duration) {
+	if w.ResetAt.IsZero() || !now.Before(w.ResetAt) {
+		w.Used = 0
+		w.ResetAt = now.Add(period)
+	}
+}
+
+// creditUsage is the per-API-key credit consumption tracked across
+// CMC's three budgeted windows.
+type creditUsage struct {
+	Minute CreditWindow
+	Day    CreditWindow
+	Month  CreditWindow
+}
+
+// CreditUsageSnapshot is a read-only copy of creditUsage, safe to hand
+// to callers.
+type CreditUsageSnapshot struct {
+	Minute CreditWindow
+	Day    CreditWindow
+	Month  CreditWindow
+}
+
+// RateLimit tracks the rate limits and call-credit budgets observed
+// across Client.Do calls.
+type RateLimit struct {
+	// The rate limit for non-search API requests.
+	Core *Rate
+
+	// The rate limit for search API requests.
+	Search *Rate
+
+	mu      sync.Mutex
+	credits map[string]*creditUsage
+}
+
+// RateLimitSnapshot is a read-only copy of a RateLimit, safe to
+// display to callers without racing concurrent Do calls.
+type RateLimitSnapshot struct {
+	Core    Rate
+	Search  Rate
+	Credits map[string]CreditUsageSnapshot
+}
+
+// Snapshot returns a point-in-time copy of the tracked rate limit and
+// credit usage state.
+func (rl *RateLimit) Snapshot() RateLimitSnapshot {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	snap := RateLimitSnapshot{Credits: make(map[string]CreditUsageSnapshot, len(rl.credits))}
+	if rl.Core != nil {
+		snap.Core = *rl.Core
+	}
+	if rl.Search != nil {
+		snap.Search = *rl.Search
+	}
+	for key, usage := range rl.credits {
+		snap.Credits[key] = CreditUsageSnapshot{Minute: usage.Minute, Day: usage.Day, Month: usage.Month}
+	}
+	return snap
+}
+
+func (rl *RateLimit) SetCoreRate(limit int, remaining int, reset time.Time) {
+	rl.Core.Limit = limit
+	rl.Core.Remaining = remaining
+	rl.Core.Reset = reset
+}
+
+func (rl *RateLimit) SetSearchRate(limit int, remaining int, reset time.Time) {
+	rl.Search.Limit = limit
+	rl.Search.Remaining = remaining
+	rl.Search.Reset = reset
+}
+
+func (rl *RateLimit) usageFor(apiKey string) *creditUsage {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.credits == nil {
+		rl.credits = make(map[string]*creditUsage)
+	}
+	usage, ok := rl.credits[apiKey]
+	if !ok {
+		usage = &creditUsage{}
+		rl.credits[apiKey] = usage
+	}
+	return usage
+}
+
+// recordCredits adds creditCount to apiKey's rolling windows, rolling
+// over any window whose deadline has passed.
+func (rl *RateLimit) recordCredits(apiKey string, creditCount int) {
+	usage := rl.usageFor(apiKey)
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	usage.Minute.rollIfExpired(now, time.Minute)
+	usage.Day.rollIfExpired(now, 24*time.Hour)
+	usage.Month.rollIfExpired(now, 30*24*time.Hour)
+
+	usage.Minute.Used += creditCount
+	usage.Day.Used += creditCount
+	usage.Month.Used += creditCount
+}
+
+// awaitBudget blocks until apiKey's tracked usage is within cfg's
+// plan limits for every window, or returns ErrRateLimited if ctx is
+// cancelled first. A zero plan limit means that window isn't tracked.
+func (rl *RateLimit) awaitBudget(ctx context.Context, apiKey string, cfg *ConfigService) error {
+	for {
+		usage := rl.usageFor(apiKey)
+
+		rl.mu.Lock()
+		now := time.Now()
+		usage.Minute.rollIfExpired(now, time.Minute)
+		usage.Day.rollIfExpired(now, 24*time.Hour)
+		usage.Month.rollIfExpired(now, 30*24*time.Hour)
+
+		window, retryAt, blocked := blockedWindow(cfg, usage, now)
+		rl.mu.Unlock()
+
+		if !blocked {
+			return nil
+		}
+
+		wait := retryAt.Sub(now)
+		if wait <= 0 {
+			continue
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &ErrRateLimited{Window: window, RetryAt: retryAt}
+		case <-timer.C:
+		}
+	}
+}
+
+// blockedWindow reports the first plan window (if any) that
+// usage has exhausted.
+func blockedWindow(cfg *ConfigService, usage *creditUsage, now time.Time) (window string, retryAt time.Time, blocked bool) {
+	if cfg.PlanCreditsPerMinute > 0 && usage.Minute.Used >= cfg.PlanCreditsPerMinute {
+		return "minute", usage.Minute.ResetAt, true
+	}
+	if cfg.PlanCreditsPerDay > 0 && usage.Day.Used >= cfg.PlanCreditsPerDay {
+		return "day", usage.Day.ResetAt, true
+	}
+	if cfg.PlanCreditsPerMonth > 0 && usage.Month.Used >= cfg.PlanCreditsPerMonth {
+		return "month", usage.Month.ResetAt, true
+	}
+	return "", time.Time{}, false
+}
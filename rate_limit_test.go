@@ -0,0 +1,167 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/v1/")
+	if err != nil {
+		t.Fatalf("parse test server URL: %v", err)
+	}
+
+	c := &Client{
+		client:    server.Client(),
+		BaseURL:   baseURL,
+		RateLimit: &RateLimit{Core: &Rate{}, Search: &Rate{}},
+		config:    &ConfigService{},
+	}
+	c.Cryptocurrency = &CryptocurrencyService{client: c}
+	c.Tools = &ToolsService{client: c}
+	c.Resolver = newResolver(c)
+	return c
+}
+
+func TestDoRecordsCreditCount(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":3},"data":{}}`))
+	})
+
+	req, err := client.NewRequest("GET", "cryptocurrency/info", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	var v struct{}
+	if _, err := client.Do(context.Background(), req, &v); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	usage := client.RateLimit.Snapshot().Credits[""]
+	if usage.Minute.Used != 3 {
+		t.Errorf("Minute.Used = %d, want 3", usage.Minute.Used)
+	}
+	if usage.Day.Used != 3 {
+		t.Errorf("Day.Used = %d, want 3", usage.Day.Used)
+	}
+	if usage.Month.Used != 3 {
+		t.Errorf("Month.Used = %d, want 3", usage.Month.Used)
+	}
+}
+
+func TestDoReturnsErrRateLimitedOn429(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":{"error_code":1008,"error_message":"too many requests"}}`))
+	})
+
+	req, err := client.NewRequest("GET", "cryptocurrency/info", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	var v struct{}
+	_, err = client.Do(context.Background(), req, &v)
+	if _, ok := err.(*ErrRateLimited); !ok {
+		t.Fatalf("Do err = %v (%T), want *ErrRateLimited", err, err)
+	}
+}
+
+func TestDoBlocksUntilBudgetAvailable(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+	client.config.AutoCheckRateLimit = true
+	client.config.PlanCreditsPerMinute = 1
+
+	usage := client.RateLimit.usageFor(client.APIKey)
+	usage.Minute = CreditWindow{Used: 1, ResetAt: time.Now().Add(50 * time.Millisecond)}
+
+	req, err := client.NewRequest("GET", "cryptocurrency/info", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var v struct{}
+	if _, err := client.Do(ctx, req, &v); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+}
+
+func TestDoServesCacheHitsWithoutAwaitingBudget(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+	client.config.Cache = NewMemoryCache()
+	client.config.PlanCreditsPerMinute = 1
+
+	req, err := client.NewRequest("GET", "cryptocurrency/info", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	var v struct{}
+	if _, err := client.Do(context.Background(), req, &v); err != nil {
+		t.Fatalf("Do (populate cache): %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1 after populating cache", calls)
+	}
+
+	// Exhaust the budget with no reset in sight and turn on
+	// AutoCheckRateLimit only now: a cache miss here would block
+	// until the context expires.
+	client.config.AutoCheckRateLimit = true
+	usage := client.RateLimit.usageFor(client.APIKey)
+	usage.Minute = CreditWindow{Used: 1, ResetAt: time.Now().Add(time.Hour)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Do(ctx, req, &v); err != nil {
+		t.Fatalf("Do (cache hit) = %v, want the cached response served without awaiting budget", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 (cache hit should not reach the server)", calls)
+	}
+}
+
+func TestDoReturnsErrRateLimitedWhenContextExpiresFirst(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+	client.config.AutoCheckRateLimit = true
+	client.config.PlanCreditsPerMinute = 1
+
+	usage := client.RateLimit.usageFor(client.APIKey)
+	usage.Minute = CreditWindow{Used: 1, ResetAt: time.Now().Add(time.Hour)}
+
+	req, err := client.NewRequest("GET", "cryptocurrency/info", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var v struct{}
+	_, err = client.Do(ctx, req, &v)
+	if _, ok := err.(*ErrRateLimited); !ok {
+		t.Fatalf("Do err = %v (%T), want *ErrRateLimited", err, err)
+	}
+}
@@ -0,0 +1,181 @@
+package coinmarketcap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// resolverMapTTL bounds how long Resolver serves the
+// /v1/cryptocurrency/map it fetched before refreshing it. The map
+// changes rarely enough that a day-long cache is safe.
+const resolverMapTTL = 24 * time.Hour
+
+// MapEntry is a single /v1/cryptocurrency/map row.
+type MapEntry = types.CryptocurrencyMapEntry
+
+// Resolver lazily fetches and caches the full /v1/cryptocurrency/map
+// so a symbol, slug, or id can be turned into the canonical id CMC's
+// other endpoints expect, without the caller guessing which of
+// several same-symbol coins (e.g. multiple "UNI"s) they meant.
+type Resolver struct {
+	client *Client
+
+	mu       sync.Mutex
+	entries  []MapEntry
+	loadedAt time.Time
+}
+
+func newResolver(c *Client) *Resolver {
+	return &Resolver{client: c}
+}
+
+func (r *Resolver) load(ctx context.Context) ([]MapEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.entries != nil && time.Since(r.loadedAt) < resolverMapTTL {
+		return r.entries, nil
+	}
+
+	resp, _, err := r.client.Cryptocurrency.Map(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r.entries = resp.Data
+	r.loadedAt = time.Now()
+	return r.entries, nil
+}
+
+// Resolve returns every map entry matching query by id, symbol, or
+// slug (case-insensitive).
+func (r *Resolver) Resolve(ctx context.Context, query string) ([]MapEntry, error) {
+	entries, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []MapEntry
+	for _, entry := range entries {
+		if entryMatchesQuery(entry, query) {
+			matches = append(matches, entry)
+		}
+	}
+	return matches, nil
+}
+
+func entryMatchesQuery(entry MapEntry, query string) bool {
+	if id, err := strconv.Atoi(query); err == nil {
+		return entry.ID == id
+	}
+	return strings.EqualFold(entry.Symbol, query) || strings.EqualFold(entry.Slug, query)
+}
+
+// IDFromSymbol resolves symbol to a single cryptocurrency id. When
+// more than one active cryptocurrency shares symbol (e.g. "UNI"), the
+// highest-ranked one (lowest Rank, i.e. largest market) wins, since
+// that's almost always the one a caller means.
+func (r *Resolver) IDFromSymbol(ctx context.Context, symbol string) (int, error) {
+	entries, err := r.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var best *MapEntry
+	for i := range entries {
+		entry := &entries[i]
+		if !strings.EqualFold(entry.Symbol, symbol) {
+			continue
+		}
+		if best == nil || rankBetter(entry.Rank, best.Rank) {
+			best = entry
+		}
+	}
+
+	if best == nil {
+		return 0, fmt.Errorf("coinmarketcap: no cryptocurrency found for symbol %q", symbol)
+	}
+	return best.ID, nil
+}
+
+// rankBetter reports whether candidate outranks current, treating an
+// unranked entry (rank 0) as worse than any ranked one.
+func rankBetter(candidate, current int) bool {
+	if candidate == 0 {
+		return false
+	}
+	return current == 0 || candidate < current
+}
+
+// Resolve returns every cryptocurrency in CMC's map matching query by
+// id, symbol, or slug.
+func (c *Client) Resolve(ctx context.Context, query string) ([]MapEntry, error) {
+	return c.Resolver.Resolve(ctx, query)
+}
+
+// IDFromSymbol resolves symbol to a single cryptocurrency id,
+// disambiguating collisions by market rank.
+func (c *Client) IDFromSymbol(ctx context.Context, symbol string) (int, error) {
+	return c.Resolver.IDFromSymbol(ctx, symbol)
+}
+
+// ResolveQuery turns a user-supplied identifier — a numeric id, a
+// symbol, or a slug — into the "id" query parameter value CMC's
+// cryptocurrency endpoints expect. CryptocurrencyService.Info and
+// LatestQuotes call this whenever a caller sets Symbol or Slug
+// instead of ID, so a single-cryptocurrency query can't be silently
+// misresolved by CMC's own disambiguation of a collided symbol (e.g.
+// "UNI") picking the wrong project.
+func (c *Client) ResolveQuery(ctx context.Context, query string) (string, error) {
+	if _, err := strconv.Atoi(query); err == nil {
+		return query, nil
+	}
+
+	matches, err := c.Resolve(ctx, query)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("coinmarketcap: no cryptocurrency found for %q", query)
+	case 1:
+		return strconv.Itoa(matches[0].ID), nil
+	default:
+		id, err := c.IDFromSymbol(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(id), nil
+	}
+}
+
+// resolveSingleQueryOption resolves symbol or slug (whichever is set)
+// to a canonical id via ResolveQuery, leaving id untouched if it's
+// already set or the query is a comma-separated batch, which
+// ResolveQuery doesn't support disambiguating entry-by-entry.
+func (c *Client) resolveSingleQueryOption(ctx context.Context, id, symbol, slug string) (resolvedID, resolvedSymbol, resolvedSlug string, err error) {
+	if id != "" {
+		return id, symbol, slug, nil
+	}
+
+	query := symbol
+	if query == "" {
+		query = slug
+	}
+	if query == "" || strings.Contains(query, ",") {
+		return id, symbol, slug, nil
+	}
+
+	id, err = c.ResolveQuery(ctx, query)
+	if err != nil {
+		return "", "", "", err
+	}
+	return id, "", "", nil
+}
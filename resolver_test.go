@@ -0,0 +1,70 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestResolverIDFromSymbolPrefersHigherRank(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":[
+			{"id":1,"rank":500,"name":"Uniswap Clone","symbol":"UNI","slug":"uniswap-clone"},
+			{"id":7083,"rank":20,"name":"Uniswap","symbol":"UNI","slug":"uniswap"}
+		]}`))
+	})
+	client.Resolver = newResolver(client)
+
+	id, err := client.IDFromSymbol(context.Background(), "uni")
+	if err != nil {
+		t.Fatalf("IDFromSymbol: %v", err)
+	}
+	if id != 7083 {
+		t.Errorf("IDFromSymbol(UNI) = %d, want 7083 (the higher-ranked project)", id)
+	}
+}
+
+func TestResolverResolveMatchesSymbolSlugOrID(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":[
+			{"id":1,"rank":1,"name":"Bitcoin","symbol":"BTC","slug":"bitcoin"}
+		]}`))
+	})
+	client.Resolver = newResolver(client)
+
+	for _, query := range []string{"BTC", "btc", "bitcoin", "1"} {
+		matches, err := client.Resolve(context.Background(), query)
+		if err != nil {
+			t.Fatalf("Resolve(%q): %v", query, err)
+		}
+		if len(matches) != 1 || matches[0].ID != 1 {
+			t.Errorf("Resolve(%q) = %v, want a single match with ID 1", query, matches)
+		}
+	}
+}
+
+func TestResolveQueryDisambiguatesBySymbol(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":[
+			{"id":1,"rank":500,"name":"Uniswap Clone","symbol":"UNI","slug":"uniswap-clone"},
+			{"id":7083,"rank":20,"name":"Uniswap","symbol":"UNI","slug":"uniswap"}
+		]}`))
+	})
+	client.Resolver = newResolver(client)
+
+	id, err := client.ResolveQuery(context.Background(), "UNI")
+	if err != nil {
+		t.Fatalf("ResolveQuery: %v", err)
+	}
+	if id != "7083" {
+		t.Errorf("ResolveQuery(UNI) = %q, want %q", id, "7083")
+	}
+
+	id, err = client.ResolveQuery(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("ResolveQuery: %v", err)
+	}
+	if id != "42" {
+		t.Errorf("ResolveQuery(42) = %q, want unchanged %q", id, "42")
+	}
+}
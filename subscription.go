@@ -0,0 +1,291 @@
+package coinmarketcap
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PriceUpdate reports a cryptocurrency's price at the time it was
+// observed to change by a Subscribe poll.
+type PriceUpdate struct {
+	Symbol    string
+	Price     float64
+	Timestamp time.Time
+}
+
+// SubscribeOptions configure a Subscribe call.
+type SubscribeOptions struct {
+	// Symbols lists the cryptocurrency symbols to track. Required.
+	Symbols []string
+
+	// Convert is the fiat or cryptocurrency symbol quotes are
+	// reported in. Defaults to "USD".
+	Convert string
+
+	// Interval is how often to poll for updates. Defaults to one
+	// minute. Overlapping subscriptions that share a Convert are
+	// coalesced onto a single upstream poll at the fastest Interval
+	// any of them requested. The poll does not slow down again when
+	// the subscriber that requested that Interval leaves.
+	Interval time.Duration
+}
+
+const defaultSubscribeInterval = time.Minute
+
+// Subscribe polls /v2/cryptocurrency/quotes/latest on opts.Interval
+// and emits a PriceUpdate on the returned channel whenever one of
+// opts.Symbols' price changes. The channel buffers one update per
+// symbol; an update that finds the buffer full is dropped, and that
+// price is not sent again. Subscribe calls that share a Convert
+// are coalesced into a single upstream request per tick, so many
+// overlapping subscribers never cost more than one ticker-style UI
+// would on its own. Polling goes through Client.Do, so it is subject
+// to the same rate-limit budget (ConfigService.AutoCheckRateLimit) as
+// any other call.
+//
+// The returned channel is closed when ctx is done. Errors encountered
+// while polling are reported on Errors() rather than the update
+// channel.
+func (c *Client) Subscribe(ctx context.Context, opts SubscribeOptions) (<-chan PriceUpdate, error) {
+	if len(opts.Symbols) == 0 {
+		return nil, errors.New("coinmarketcap: Subscribe requires at least one symbol")
+	}
+
+	convert := opts.Convert
+	if convert == "" {
+		convert = "USD"
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultSubscribeInterval
+	}
+
+	hub := c.subscriptionHub()
+	sub, group := hub.join(convert, interval, opts.Symbols)
+
+	go func() {
+		<-ctx.Done()
+		hub.leave(group, sub.id)
+	}()
+
+	return sub.updates, nil
+}
+
+// Errors returns the channel that Subscribe polling errors are
+// reported on. It is shared by every subscription active on c.
+func (c *Client) Errors() <-chan error {
+	return c.subscriptionHub().errs
+}
+
+func (c *Client) subscriptionHub() *subscriptionHub {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+	if c.subs == nil {
+		c.subs = &subscriptionHub{
+			client: c,
+			groups: make(map[string]*pollGroup),
+			errs:   make(chan error, 16),
+		}
+	}
+	return c.subs
+}
+
+// subscription is one caller's view into a pollGroup: the symbols it
+// cares about, the prices it last saw for them, and the channel its
+// updates are delivered on.
+type subscription struct {
+	id      int
+	symbols map[string]bool
+	last    map[string]float64
+	updates chan PriceUpdate
+}
+
+// pollGroup coalesces every subscription for a given Convert into a
+// single upstream LatestQuotes call per tick, covering the union of
+// their symbols.
+type pollGroup struct {
+	client  *Client
+	convert string
+	errs    chan error
+
+	mu       sync.Mutex
+	interval time.Duration
+	subs     map[int]*subscription
+	stop     chan struct{}
+}
+
+func (g *pollGroup) start() {
+	stop := g.stop
+	interval := g.interval
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				g.poll()
+			}
+		}
+	}()
+}
+
+func (g *pollGroup) poll() {
+	symbols := g.symbolSet()
+	if len(symbols) == 0 {
+		return
+	}
+
+	resp, _, err := g.client.Cryptocurrency.LatestQuotes(context.Background(), &QuotesLatestOptions{
+		Symbol:  strings.Join(symbols, ","),
+		Convert: g.convert,
+	})
+	if err != nil {
+		g.reportErr(err)
+		return
+	}
+
+	prices := make(map[string]float64, len(resp.Data))
+	now := time.Now()
+	for _, item := range resp.Data {
+		quote, ok := item.Quote[g.convert]
+		if !ok {
+			continue
+		}
+		prices[item.Symbol] = quote.Price
+		if !quote.LastUpdated.IsZero() {
+			now = quote.LastUpdated
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, sub := range g.subs {
+		for symbol := range sub.symbols {
+			price, ok := prices[symbol]
+			if !ok {
+				continue
+			}
+			if prev, seen := sub.last[symbol]; seen && prev == price {
+				continue
+			}
+			sub.last[symbol] = price
+
+			select {
+			case sub.updates <- PriceUpdate{Symbol: symbol, Price: price, Timestamp: now}:
+			default:
+			}
+		}
+	}
+}
+
+func (g *pollGroup) reportErr(err error) {
+	select {
+	case g.errs <- err:
+	default:
+	}
+}
+
+func (g *pollGroup) symbolSet() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	set := make(map[string]bool)
+	for _, sub := range g.subs {
+		for symbol := range sub.symbols {
+			set[symbol] = true
+		}
+	}
+
+	symbols := make([]string, 0, len(set))
+	for symbol := range set {
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// subscriptionHub tracks the active pollGroups for a Client, one per
+// distinct Convert currency.
+type subscriptionHub struct {
+	client *Client
+	errs   chan error
+
+	mu     sync.Mutex
+	nextID int
+	groups map[string]*pollGroup
+}
+
+func (h *subscriptionHub) join(convert string, interval time.Duration, symbols []string) (*subscription, *pollGroup) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	group, ok := h.groups[convert]
+	switch {
+	case !ok:
+		group = &pollGroup{
+			client:   h.client,
+			convert:  convert,
+			errs:     h.errs,
+			interval: interval,
+			subs:     make(map[int]*subscription),
+			stop:     make(chan struct{}),
+		}
+		h.groups[convert] = group
+		group.start()
+	case interval < group.interval:
+		// A faster subscriber joined an existing group; restart its
+		// poll loop at the new, faster pace.
+		close(group.stop)
+		group.stop = make(chan struct{})
+		group.interval = interval
+		group.start()
+	}
+
+	h.nextID++
+	sub := &subscription{
+		id:      h.nextID,
+		symbols: toSymbolSet(symbols),
+		last:    make(map[string]float64),
+		updates: make(chan PriceUpdate, len(symbols)),
+	}
+
+	group.mu.Lock()
+	group.subs[sub.id] = sub
+	group.mu.Unlock()
+
+	return sub, group
+}
+
+func (h *subscriptionHub) leave(group *pollGroup, id int) {
+	group.mu.Lock()
+	sub, ok := group.subs[id]
+	if ok {
+		delete(group.subs, id)
+		close(sub.updates)
+	}
+	empty := len(group.subs) == 0
+	group.mu.Unlock()
+
+	if !ok || !empty {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.groups[group.convert] == group {
+		close(group.stop)
+		delete(h.groups, group.convert)
+	}
+}
+
+func toSymbolSet(symbols []string) map[string]bool {
+	set := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		set[symbol] = true
+	}
+	return set
+}
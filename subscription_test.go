@@ -0,0 +1,90 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSubscribeCoalescesOverlappingSubscriptions(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if want := "/v2/cryptocurrency/quotes/latest"; r.URL.Path != want {
+			t.Errorf("request path = %q, want %q", r.URL.Path, want)
+		}
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{
+			"1":{"id":1,"name":"Bitcoin","symbol":"BTC","quote":{"USD":{"price":50000}}},
+			"2":{"id":2,"name":"Ethereum","symbol":"ETH","quote":{"USD":{"price":3000}}}
+		}}`))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updatesA, err := client.Subscribe(ctx, SubscribeOptions{Symbols: []string{"BTC"}, Interval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	updatesB, err := client.Subscribe(ctx, SubscribeOptions{Symbols: []string{"ETH"}, Interval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	select {
+	case u := <-updatesA:
+		if u.Symbol != "BTC" || u.Price != 50000 {
+			t.Errorf("updatesA = %+v, want BTC @ 50000", u)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for BTC update")
+	}
+
+	select {
+	case u := <-updatesB:
+		if u.Symbol != "ETH" || u.Price != 3000 {
+			t.Errorf("updatesB = %+v, want ETH @ 3000", u)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for ETH update")
+	}
+
+	if n := atomic.LoadInt32(&calls); n > 2 {
+		t.Errorf("server called %d times, want overlapping subscriptions coalesced onto one poll per tick", n)
+	}
+}
+
+func TestSubscribeClosesChannelWhenContextDone(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{}}`))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := client.Subscribe(ctx, SubscribeOptions{Symbols: []string{"BTC"}, Interval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-updates:
+		if ok {
+			t.Fatal("expected updates channel to close, got a value instead")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for updates channel to close")
+	}
+}
+
+func TestSubscribeRequiresSymbols(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be called")
+	})
+
+	if _, err := client.Subscribe(context.Background(), SubscribeOptions{}); err == nil {
+		t.Fatal("Subscribe with no symbols: want error, got nil")
+	}
+}
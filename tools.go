@@ -0,0 +1,54 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/whyrans/go-coinmarketcap/types"
+)
+
+// PriceConversionOptions are the query parameters accepted by
+// /v2/tools/price-conversion. Either ID or Symbol must be set.
+type PriceConversionOptions struct {
+	Amount  float64
+	ID      string
+	Symbol  string
+	Time    string
+	Convert string
+}
+
+// PriceConversion converts an amount of one cryptocurrency or fiat
+// currency into up to 120 other currencies at the same historical or
+// current market rate.
+func (s *ToolsService) PriceConversion(ctx context.Context, opts *PriceConversionOptions) (*types.PriceConversionResponse, *Response, error) {
+	v := url.Values{}
+	if opts != nil {
+		v.Set("amount", strconv.FormatFloat(opts.Amount, 'f', -1, 64))
+		if opts.ID != "" {
+			v.Set("id", opts.ID)
+		}
+		if opts.Symbol != "" {
+			v.Set("symbol", opts.Symbol)
+		}
+		if opts.Time != "" {
+			v.Set("time", opts.Time)
+		}
+		if opts.Convert != "" {
+			v.Set("convert", opts.Convert)
+		}
+	}
+
+	req, err := s.client.NewRequestV2("GET", withQuery("tools/price-conversion", v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result types.PriceConversionResponse
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
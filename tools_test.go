@@ -0,0 +1,28 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestPriceConversionRequestsV2Path(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if want := "/v2/tools/price-conversion"; r.URL.Path != want {
+			t.Errorf("request path = %q, want %q", r.URL.Path, want)
+		}
+		w.Write([]byte(`{"status":{"credit_count":1},"data":{"id":1,"symbol":"BTC","amount":1,"quote":{"USD":{"price":50000}}}}`))
+	})
+
+	resp, _, err := client.Tools.PriceConversion(context.Background(), &PriceConversionOptions{
+		Amount:  1,
+		Symbol:  "BTC",
+		Convert: "USD",
+	})
+	if err != nil {
+		t.Fatalf("PriceConversion: %v", err)
+	}
+	if resp.Data.Quote["USD"].Price != 50000 {
+		t.Errorf("price = %v, want 50000", resp.Data.Quote["USD"].Price)
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import "time"
+
+// CryptocurrencyURLs groups the external links reported for a
+// cryptocurrency by /v1/cryptocurrency/info.
+type CryptocurrencyURLs struct {
+	Website      []string `json:"website"`
+	TechnicalDoc []string `json:"technical_doc"`
+	Twitter      []string `json:"twitter"`
+	Reddit       []string `json:"reddit"`
+	MessageBoard []string `json:"message_board"`
+	Announcement []string `json:"announcement"`
+	Chat         []string `json:"chat"`
+	Explorer     []string `json:"explorer"`
+	SourceCode   []string `json:"source_code"`
+}
+
+// CryptocurrencyInfo is the static metadata for a single
+// cryptocurrency, as returned by /v1/cryptocurrency/info.
+type CryptocurrencyInfo struct {
+	ID          int                `json:"id"`
+	Name        string             `json:"name"`
+	Symbol      string             `json:"symbol"`
+	Slug        string             `json:"slug"`
+	Category    string             `json:"category"`
+	Description string             `json:"description"`
+	Logo        string             `json:"logo"`
+	DateAdded   time.Time          `json:"date_added"`
+	URLs        CryptocurrencyURLs `json:"urls"`
+}
+
+// CryptocurrencyInfoResponse is the response payload for
+// /v1/cryptocurrency/info. Data is keyed by cryptocurrency id,
+// matching the CMC response shape.
+type CryptocurrencyInfoResponse struct {
+	Status Status                        `json:"status"`
+	Data   map[string]CryptocurrencyInfo `json:"data"`
+}
@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// CryptocurrencyListing is a single ranked cryptocurrency entry with
+// quotes, as returned by /v1/cryptocurrency/listings/latest.
+type CryptocurrencyListing struct {
+	ID                int                            `json:"id"`
+	Name              string                         `json:"name"`
+	Symbol            string                         `json:"symbol"`
+	Slug              string                         `json:"slug"`
+	CMCRank           int                            `json:"cmc_rank"`
+	CirculatingSupply float64                        `json:"circulating_supply"`
+	TotalSupply       float64                        `json:"total_supply"`
+	MaxSupply         float64                        `json:"max_supply"`
+	LastUpdated       time.Time                      `json:"last_updated"`
+	Quote             map[string]CryptocurrencyQuote `json:"quote"`
+}
+
+// CryptocurrencyLatestListingsResponse is the response payload for
+// /v1/cryptocurrency/listings/latest.
+type CryptocurrencyLatestListingsResponse struct {
+	Status Status                  `json:"status"`
+	Data   []CryptocurrencyListing `json:"data"`
+}
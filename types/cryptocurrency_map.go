@@ -0,0 +1,23 @@
+package types
+
+import "time"
+
+// CryptocurrencyMapEntry is a single row of the id, symbol, slug, and
+// name index returned by /v1/cryptocurrency/map.
+type CryptocurrencyMapEntry struct {
+	ID                  int       `json:"id"`
+	Rank                int       `json:"rank"`
+	Name                string    `json:"name"`
+	Symbol              string    `json:"symbol"`
+	Slug                string    `json:"slug"`
+	IsActive            int       `json:"is_active"`
+	FirstHistoricalData time.Time `json:"first_historical_data"`
+	LastHistoricalData  time.Time `json:"last_historical_data"`
+}
+
+// CryptocurrencyMapResponse is the response payload for
+// /v1/cryptocurrency/map.
+type CryptocurrencyMapResponse struct {
+	Status Status                   `json:"status"`
+	Data   []CryptocurrencyMapEntry `json:"data"`
+}
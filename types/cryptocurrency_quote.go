@@ -0,0 +1,17 @@
+package types
+
+import "time"
+
+// CryptocurrencyQuote is a single currency's worth of market quote
+// data, shared by /v1/cryptocurrency/listings/latest,
+// /v2/cryptocurrency/quotes/latest, and
+// /v2/cryptocurrency/quotes/historical.
+type CryptocurrencyQuote struct {
+	Price            float64   `json:"price"`
+	Volume24h        float64   `json:"volume_24h"`
+	MarketCap        float64   `json:"market_cap"`
+	PercentChange1h  float64   `json:"percent_change_1h"`
+	PercentChange24h float64   `json:"percent_change_24h"`
+	PercentChange7d  float64   `json:"percent_change_7d"`
+	LastUpdated      time.Time `json:"last_updated"`
+}
@@ -0,0 +1,107 @@
+package types
+
+import "time"
+
+// ExchangeURLs groups the external links reported for an exchange by
+// /v1/exchange/info.
+type ExchangeURLs struct {
+	Website []string `json:"website"`
+	Twitter []string `json:"twitter"`
+	Blog    []string `json:"blog"`
+	Chat    []string `json:"chat"`
+	Fee     []string `json:"fee"`
+}
+
+// ExchangeInfo is the static metadata for a single exchange, as
+// returned by /v1/exchange/info.
+type ExchangeInfo struct {
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	Slug         string       `json:"slug"`
+	Description  string       `json:"description"`
+	Notice       string       `json:"notice"`
+	DateLaunched time.Time    `json:"date_launched"`
+	Countries    []string     `json:"countries"`
+	Fiats        []string     `json:"fiats"`
+	Tags         []string     `json:"tags"`
+	Type         string       `json:"type"`
+	Logo         string       `json:"logo"`
+	URLs         ExchangeURLs `json:"urls"`
+}
+
+// ExchangeInfoResponse is the response payload for /v1/exchange/info.
+// Data is keyed by exchange id, matching the CMC response shape.
+type ExchangeInfoResponse struct {
+	Status Status                  `json:"status"`
+	Data   map[string]ExchangeInfo `json:"data"`
+}
+
+// ExchangeMapEntry is a single entry in the /v1/exchange/map id index.
+type ExchangeMapEntry struct {
+	ID                  int       `json:"id"`
+	Name                string    `json:"name"`
+	Slug                string    `json:"slug"`
+	IsActive            int       `json:"is_active"`
+	FirstHistoricalData time.Time `json:"first_historical_data"`
+	LastHistoricalData  time.Time `json:"last_historical_data"`
+}
+
+// ExchangeMapResponse is the response payload for /v1/exchange/map.
+type ExchangeMapResponse struct {
+	Status Status             `json:"status"`
+	Data   []ExchangeMapEntry `json:"data"`
+}
+
+// ExchangeQuote is a single currency's worth of market quote data for
+// an exchange, as reported by /v1/exchange/listings/latest.
+type ExchangeQuote struct {
+	Volume24h   float64   `json:"volume_24h"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// ExchangeListing is a single exchange entry with quotes, as returned
+// by /v1/exchange/listings/latest.
+type ExchangeListing struct {
+	ID             int                      `json:"id"`
+	Name           string                   `json:"name"`
+	Slug           string                   `json:"slug"`
+	NumMarketPairs int                      `json:"num_market_pairs"`
+	LastUpdated    time.Time                `json:"last_updated"`
+	Quote          map[string]ExchangeQuote `json:"quote"`
+}
+
+// ExchangeLatestListingsResponse is the response payload for
+// /v1/exchange/listings/latest.
+type ExchangeLatestListingsResponse struct {
+	Status Status            `json:"status"`
+	Data   []ExchangeListing `json:"data"`
+}
+
+// MarketPairQuote is a single currency's worth of quote data for a
+// market pair, as reported by /v1/exchange/market-pairs/latest.
+type MarketPairQuote struct {
+	Price       float64   `json:"price"`
+	Volume24h   float64   `json:"volume_24h"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// ExchangeMarketPair is a single market pair traded on an exchange.
+type ExchangeMarketPair struct {
+	MarketID   int                        `json:"market_id"`
+	MarketPair string                     `json:"market_pair"`
+	Category   string                     `json:"category"`
+	FeeType    string                     `json:"fee_type"`
+	Quote      map[string]MarketPairQuote `json:"quote"`
+}
+
+// ExchangeMarketPairsResponse is the response payload for
+// /v1/exchange/market-pairs/latest.
+type ExchangeMarketPairsResponse struct {
+	Status Status `json:"status"`
+	Data   struct {
+		ID             int                  `json:"id"`
+		Name           string               `json:"name"`
+		NumMarketPairs int                  `json:"num_market_pairs"`
+		MarketPairs    []ExchangeMarketPair `json:"market_pairs"`
+	} `json:"data"`
+}
@@ -0,0 +1,51 @@
+package types
+
+import "time"
+
+// GlobalMetricsQuote is a single currency's worth of aggregate market
+// quote data.
+type GlobalMetricsQuote struct {
+	TotalMarketCap   float64   `json:"total_market_cap"`
+	TotalVolume24h   float64   `json:"total_volume_24h"`
+	AltcoinMarketCap float64   `json:"altcoin_market_cap"`
+	AltcoinVolume24h float64   `json:"altcoin_volume_24h"`
+	LastUpdated      time.Time `json:"last_updated"`
+}
+
+// GlobalMetrics is the aggregate market data returned by
+// /v1/global-metrics/quotes/latest.
+type GlobalMetrics struct {
+	BTCDominance           float64                       `json:"btc_dominance"`
+	ETHDominance           float64                       `json:"eth_dominance"`
+	ActiveCryptocurrencies int                           `json:"active_cryptocurrencies"`
+	ActiveExchanges        int                           `json:"active_exchanges"`
+	ActiveMarketPairs      int                           `json:"active_market_pairs"`
+	LastUpdated            time.Time                     `json:"last_updated"`
+	Quote                  map[string]GlobalMetricsQuote `json:"quote"`
+}
+
+// GlobalMetricsLatestResponse is the response payload for
+// /v1/global-metrics/quotes/latest.
+type GlobalMetricsLatestResponse struct {
+	Status Status        `json:"status"`
+	Data   GlobalMetrics `json:"data"`
+}
+
+// GlobalMetricsHistoricalQuote is a single point-in-time sample
+// returned by /v1/global-metrics/quotes/historical.
+type GlobalMetricsHistoricalQuote struct {
+	Timestamp              time.Time                     `json:"timestamp"`
+	BTCDominance           float64                       `json:"btc_dominance"`
+	ActiveCryptocurrencies int                           `json:"active_cryptocurrencies"`
+	ActiveMarketPairs      int                           `json:"active_market_pairs"`
+	Quote                  map[string]GlobalMetricsQuote `json:"quote"`
+}
+
+// GlobalMetricsHistoricalResponse is the response payload for
+// /v1/global-metrics/quotes/historical.
+type GlobalMetricsHistoricalResponse struct {
+	Status Status `json:"status"`
+	Data   struct {
+		Quotes []GlobalMetricsHistoricalQuote `json:"quotes"`
+	} `json:"data"`
+}
@@ -0,0 +1,41 @@
+package types
+
+import "time"
+
+// OHLCVQuote is a single currency's worth of open/high/low/close
+// candle data.
+type OHLCVQuote struct {
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	Volume    float64   `json:"volume"`
+	MarketCap float64   `json:"market_cap"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// OHLCVHistoricalQuote is a single candle, as returned by
+// /v2/cryptocurrency/ohlcv/historical.
+type OHLCVHistoricalQuote struct {
+	TimeOpen  time.Time             `json:"time_open"`
+	TimeClose time.Time             `json:"time_close"`
+	TimeHigh  time.Time             `json:"time_high"`
+	TimeLow   time.Time             `json:"time_low"`
+	Quote     map[string]OHLCVQuote `json:"quote"`
+}
+
+// OHLCVHistorical is a single cryptocurrency's candle series.
+type OHLCVHistorical struct {
+	ID     int                    `json:"id"`
+	Name   string                 `json:"name"`
+	Symbol string                 `json:"symbol"`
+	Quotes []OHLCVHistoricalQuote `json:"quotes"`
+}
+
+// OHLCVHistoricalResponse is the response payload for
+// /v2/cryptocurrency/ohlcv/historical. Data is keyed by
+// cryptocurrency id, matching the CMC v2 response shape.
+type OHLCVHistoricalResponse struct {
+	Status Status                     `json:"status"`
+	Data   map[string]OHLCVHistorical `json:"data"`
+}
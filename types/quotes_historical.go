@@ -0,0 +1,26 @@
+package types
+
+import "time"
+
+// QuotesHistoricalQuote is a single timestamped market quote sample,
+// as returned by /v2/cryptocurrency/quotes/historical.
+type QuotesHistoricalQuote struct {
+	Timestamp time.Time                      `json:"timestamp"`
+	Quote     map[string]CryptocurrencyQuote `json:"quote"`
+}
+
+// QuotesHistorical is a single cryptocurrency's quote history.
+type QuotesHistorical struct {
+	ID     int                     `json:"id"`
+	Name   string                  `json:"name"`
+	Symbol string                  `json:"symbol"`
+	Quotes []QuotesHistoricalQuote `json:"quotes"`
+}
+
+// QuotesHistoricalResponse is the response payload for
+// /v2/cryptocurrency/quotes/historical. Data is keyed by
+// cryptocurrency id, matching the CMC v2 response shape.
+type QuotesHistoricalResponse struct {
+	Status Status                      `json:"status"`
+	Data   map[string]QuotesHistorical `json:"data"`
+}
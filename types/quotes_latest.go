@@ -0,0 +1,18 @@
+package types
+
+// CryptocurrencyLatest is a single cryptocurrency's latest market
+// quote, as returned by /v2/cryptocurrency/quotes/latest.
+type CryptocurrencyLatest struct {
+	ID     int                            `json:"id"`
+	Name   string                         `json:"name"`
+	Symbol string                         `json:"symbol"`
+	Quote  map[string]CryptocurrencyQuote `json:"quote"`
+}
+
+// QuotesLatestResponse is the response payload for
+// /v2/cryptocurrency/quotes/latest. Data is keyed by cryptocurrency
+// id, matching the CMC v2 response shape.
+type QuotesLatestResponse struct {
+	Status Status                          `json:"status"`
+	Data   map[string]CryptocurrencyLatest `json:"data"`
+}
@@ -0,0 +1,14 @@
+package types
+
+import "time"
+
+// Status is the API call metadata object returned alongside the "data"
+// payload on every CoinMarketCap Pro API response.
+type Status struct {
+	Timestamp    time.Time `json:"timestamp"`
+	ErrorCode    int       `json:"error_code"`
+	ErrorMessage string    `json:"error_message"`
+	Elapsed      int       `json:"elapsed"`
+	CreditCount  int       `json:"credit_count"`
+	Notice       string    `json:"notice,omitempty"`
+}
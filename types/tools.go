@@ -0,0 +1,28 @@
+package types
+
+import "time"
+
+// PriceConversionQuote is a single target currency's worth of
+// converted amount data.
+type PriceConversionQuote struct {
+	Price       float64   `json:"price"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// PriceConversion is the converted amount for a single source
+// cryptocurrency or fiat currency, as returned by
+// /v2/tools/price-conversion.
+type PriceConversion struct {
+	ID          int                             `json:"id"`
+	Symbol      string                          `json:"symbol"`
+	Amount      float64                         `json:"amount"`
+	LastUpdated time.Time                       `json:"last_updated"`
+	Quote       map[string]PriceConversionQuote `json:"quote"`
+}
+
+// PriceConversionResponse is the response payload for
+// /v2/tools/price-conversion.
+type PriceConversionResponse struct {
+	Status Status          `json:"status"`
+	Data   PriceConversion `json:"data"`
+}